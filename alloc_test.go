@@ -0,0 +1,60 @@
+package clock
+
+import "testing"
+
+// TestAllocations locks in the operations that are expected not to allocate,
+// for callers holding large numbers of Times in memory.
+func TestAllocations(t *testing.T) {
+	tm := NewTime(10, 11, 12)
+	start, end := NewTime(9, 0, 0), NewTime(17, 0, 0)
+	text := []byte("10:11:12")
+	buf := make([]byte, 0, 64)
+
+	for name, f := range map[string]func(){
+		"UnmarshalText": func() { _ = tm.UnmarshalText(text) },
+		"AppendText":    func() { _, _ = tm.AppendText(buf[:0]) },
+		"AppendFormat":  func() { _ = tm.AppendFormat(buf[:0], PrecisionAuto) },
+		"AppendBinary":  func() { _, _ = tm.AppendBinary(buf[:0]) },
+		"Compare":       func() { _ = tm.Compare(start) },
+		"Within":        func() { _ = tm.Within(start, end) },
+		"Add":           func() { _ = tm.Add(90) },
+	} {
+		if allocs := testing.AllocsPerRun(100, f); allocs != 0 {
+			t.Errorf("%s allocated %v times", name, allocs)
+		}
+	}
+}
+
+func BenchmarkUnmarshalText(b *testing.B) {
+	var tm Time
+	text := []byte("10:11:12")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = tm.UnmarshalText(text)
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	tm := NewTime(10, 11, 12)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = tm.AppendFormat(buf[:0], PrecisionSeconds)
+	}
+}
+
+func BenchmarkCompare(b *testing.B) {
+	tm, other := NewTime(10, 11, 12), NewTime(9, 0, 0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = tm.Compare(other)
+	}
+}
+
+func BenchmarkWithin(b *testing.B) {
+	tm, start, end := NewTime(10, 11, 12), NewTime(22, 0, 0), NewTime(6, 0, 0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = tm.Within(start, end)
+	}
+}
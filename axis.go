@@ -0,0 +1,81 @@
+package clock
+
+import "time"
+
+// tickSteps are the spacings NiceTicks chooses between, from finest to
+// coarsest.
+var tickSteps = []time.Duration{
+	time.Second, 2 * time.Second, 5 * time.Second, 10 * time.Second, 15 * time.Second, 30 * time.Second,
+	time.Minute, 2 * time.Minute, 5 * time.Minute, 10 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour, 2 * time.Hour, 3 * time.Hour, 4 * time.Hour, 6 * time.Hour, 12 * time.Hour,
+}
+
+// NiceTicks returns tick positions for a time of day axis from from to to
+// inclusive, such as 06:00, 12:00, and 18:00.  The ticks fall on multiples
+// of the finest round step from midnight, from one second up to twelve
+// hours, that gives at least one and at most maxTicks ticks.  If no step
+// does, the first maxTicks ticks of the coarsest step giving any are
+// returned.  Nil is returned if to is before from or maxTicks is less than
+// one.
+func NiceTicks(from, to Time, maxTicks int) []Time {
+	if to.TotalSeconds() < from.TotalSeconds() || maxTicks < 1 {
+		return nil
+	}
+
+	// One second steps always give a tick, as to is not before from.
+	width := 1
+	for _, step := range tickSteps {
+		w := int(step / time.Second)
+		n := tickCount(from, to, w)
+		if n == 0 {
+			continue
+		}
+
+		width = w
+		if n <= maxTicks {
+			break
+		}
+	}
+
+	first := (from.TotalSeconds() + width - 1) / width * width
+	n := tickCount(from, to, width)
+	if n > maxTicks {
+		n = maxTicks
+	}
+
+	ticks := make([]Time, n)
+	for i := range ticks {
+		ticks[i] = fromSeconds(first + i*width)
+	}
+
+	return ticks
+}
+
+// tickCount returns the number of multiples of width seconds from from to
+// to inclusive.
+func tickCount(from, to Time, width int) int {
+	first := (from.TotalSeconds() + width - 1) / width
+	last := to.TotalSeconds() / width
+
+	return last - first + 1
+}
+
+// TickLabels returns a label for each of the ticks.  The labels are of the
+// form hh:mm, unless any of the ticks has seconds, in which case all of the
+// labels are of the form hh:mm:ss.
+func TickLabels(ticks []Time) []string {
+	p := PrecisionMinutes
+	for _, t := range ticks {
+		if t.seconds != 0 {
+			p = PrecisionSeconds
+			break
+		}
+	}
+
+	labels := make([]string, len(ticks))
+	for i, t := range ticks {
+		labels[i] = t.Format(p)
+	}
+
+	return labels
+}
@@ -0,0 +1,27 @@
+package clock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNiceTicks(t *testing.T) {
+	ticks := NiceTicks(StartOfDayTime, EndOfDayTime, 5)
+	assert.Equal(t, []string{"00:00", "06:00", "12:00", "18:00"}, TickLabels(ticks))
+
+	ticks = NiceTicks(NewTime(9, 7, 0), NewTime(17, 0, 0), 10)
+	assert.Equal(t, []string{"10:00", "11:00", "12:00", "13:00", "14:00", "15:00", "16:00", "17:00"}, TickLabels(ticks))
+
+	ticks = NiceTicks(NewTime(9, 0, 0), NewTime(9, 1, 0), 4)
+	assert.Equal(t, []string{"09:00:00", "09:00:30", "09:01:00"}, TickLabels(ticks))
+
+	assert.Len(t, NiceTicks(StartOfDayTime, EndOfDayTime, 1), 1)
+	assert.Len(t, NiceTicks(StartOfDayTime, EndOfDayTime, secondsPerDay), secondsPerDay)
+	assert.Len(t, NiceTicks(StartOfDayTime, EndOfDayTime, secondsPerDay-1), secondsPerDay/2)
+	assert.Equal(t, []Time{NewTime(10, 0, 2)}, NiceTicks(NewTime(10, 0, 1), NewTime(10, 0, 4), 1))
+	assert.Equal(t, []Time{NewTime(10, 0, 2), NewTime(10, 0, 4)}, NiceTicks(NewTime(10, 0, 1), NewTime(10, 0, 4), 2))
+	assert.Equal(t, []Time{NewTime(10, 0, 5)}, NiceTicks(NewTime(10, 0, 5), NewTime(10, 0, 5), 3))
+	assert.Nil(t, NiceTicks(NewTime(10, 0, 0), NewTime(9, 0, 0), 5))
+	assert.Nil(t, NiceTicks(StartOfDayTime, EndOfDayTime, 0))
+}
@@ -0,0 +1,109 @@
+package clock
+
+import (
+	"math/bits"
+	"time"
+)
+
+// Bitmap is a compact representation of the times of day that are occupied,
+// with one bit per minute or per second of the day, for intersecting large
+// numbers of availability masks.
+type Bitmap struct {
+	width int
+	slots int
+	words []uint64
+}
+
+// NewBitmap returns an empty Bitmap.  Resolutions of a minute or more give one
+// bit per minute, and anything less gives one bit per second.
+func NewBitmap(resolution time.Duration) *Bitmap {
+	width := 60
+	if resolution < time.Minute {
+		width = 1
+	}
+	slots := secondsPerDay / width
+
+	return &Bitmap{
+		width: width,
+		slots: slots,
+		words: make([]uint64, (slots+63)/64),
+	}
+}
+
+// Resolution returns the length of time covered by each bit.
+func (b *Bitmap) Resolution() time.Duration {
+	return time.Duration(b.width) * time.Second
+}
+
+// Fill marks every slot that overlaps the time from start up to end as
+// occupied.  If end is before start the range wraps around midnight, and if
+// they are equal nothing is marked.  Times outside of a single day wrap
+// around it.
+func (b *Bitmap) Fill(start, end Time) {
+	start, end = start.Normalize(), end.Normalize()
+	width := b.Resolution()
+	from := int(start.sinceMidnight() / width)
+	to := int((end.sinceMidnight() + width - 1) / width)
+
+	if end.sinceMidnight() < start.sinceMidnight() {
+		b.fill(from, b.slots)
+		from = 0
+	}
+	b.fill(from, to)
+}
+
+func (b *Bitmap) fill(from, to int) {
+	for i := from; i < to; i++ {
+		b.words[i/64] |= 1 << uint(i%64)
+	}
+}
+
+// Contains reports whether the slot holding the Time is occupied.
+func (b *Bitmap) Contains(t Time) bool {
+	i := int(t.Normalize().sinceMidnight() / b.Resolution())
+	return b.words[i/64]&(1<<uint(i%64)) != 0
+}
+
+// And keeps only the slots that are also occupied in other.  It panics if
+// the resolutions of the Bitmaps differ.
+func (b *Bitmap) And(other *Bitmap) {
+	b.check(other)
+	for i, w := range other.words {
+		b.words[i] &= w
+	}
+}
+
+// Or adds the slots that are occupied in other.  It panics if the
+// resolutions of the Bitmaps differ.
+func (b *Bitmap) Or(other *Bitmap) {
+	b.check(other)
+	for i, w := range other.words {
+		b.words[i] |= w
+	}
+}
+
+// Not inverts every slot of the Bitmap.
+func (b *Bitmap) Not() {
+	for i := range b.words {
+		b.words[i] = ^b.words[i]
+	}
+	if extra := b.slots % 64; extra != 0 {
+		b.words[len(b.words)-1] &= 1<<uint(extra) - 1
+	}
+}
+
+func (b *Bitmap) check(other *Bitmap) {
+	if b.width != other.width {
+		panic("clock: Bitmap resolutions differ")
+	}
+}
+
+// Duration returns the total length of time occupied.
+func (b *Bitmap) Duration() time.Duration {
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+
+	return time.Duration(n*b.width) * time.Second
+}
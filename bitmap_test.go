@@ -0,0 +1,75 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBitmap(t *testing.T) {
+	b := NewBitmap(time.Minute)
+	assert.Equal(t, time.Minute, b.Resolution())
+	assert.Equal(t, time.Duration(0), b.Duration())
+
+	b.Fill(NewTime(9, 0, 0), NewTime(17, 0, 0))
+	assert.Equal(t, 8*time.Hour, b.Duration())
+	assert.True(t, b.Contains(NewTime(9, 0, 0)))
+	assert.True(t, b.Contains(NewTime(16, 59, 59)))
+	assert.False(t, b.Contains(NewTime(17, 0, 0)))
+
+	lunch := NewBitmap(time.Minute)
+	lunch.Fill(NewTime(12, 0, 0), NewTime(13, 0, 0))
+	lunch.Not()
+	assert.Equal(t, 23*time.Hour, lunch.Duration())
+
+	b.And(lunch)
+	assert.Equal(t, 7*time.Hour, b.Duration())
+	assert.False(t, b.Contains(NewTime(12, 30, 0)))
+
+	night := NewBitmap(time.Minute)
+	night.Fill(NewTime(22, 0, 0), NewTime(6, 0, 0))
+	assert.Equal(t, 8*time.Hour, night.Duration())
+	assert.True(t, night.Contains(StartOfDayTime))
+
+	b.Or(night)
+	assert.Equal(t, 15*time.Hour, b.Duration())
+
+	// Partially covered minutes are occupied.
+	partial := NewBitmap(time.Minute)
+	partial.Fill(NewTime(9, 0, 30), NewTime(9, 1, 30))
+	assert.Equal(t, 2*time.Minute, partial.Duration())
+
+	assert.Panics(t, func() { b.And(NewBitmap(time.Second)) })
+}
+
+func TestBitmapSeconds(t *testing.T) {
+	b := NewBitmap(time.Second)
+	assert.Equal(t, time.Second, b.Resolution())
+
+	b.Fill(NewTime(9, 0, 30), NewTime(9, 1, 30))
+	assert.Equal(t, time.Minute, b.Duration())
+	assert.False(t, b.Contains(NewTime(9, 0, 29)))
+
+	b.Not()
+	assert.Equal(t, 24*time.Hour-time.Minute, b.Duration())
+
+	// Partially covered seconds are occupied.
+	b = NewBitmap(time.Second)
+	b.Fill(NewTime(9, 0, 0), NewTimeNano(9, 0, 1, 500000000))
+	assert.Equal(t, 2*time.Second, b.Duration())
+}
+
+func TestBitmapOutOfRange(t *testing.T) {
+	b := NewBitmap(time.Minute)
+	assert.NotPanics(t, func() { b.Fill(NewTime(25, 0, 0), NewTime(26, 30, 0)) })
+	assert.Equal(t, 90*time.Minute, b.Duration())
+	assert.True(t, b.Contains(NewTime(1, 0, 0)))
+	assert.True(t, b.Contains(NewTime(25, 0, 0)))
+	assert.False(t, b.Contains(NewTime(26, 30, 0)))
+	assert.False(t, b.Contains(NewTime(0, -1, 0)))
+
+	b = NewBitmap(time.Second)
+	b.Fill(NewTime(23, 0, 0), NewTime(24, 0, 0))
+	assert.Equal(t, time.Hour, b.Duration())
+}
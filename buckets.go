@@ -0,0 +1,56 @@
+package clock
+
+import "time"
+
+// secondsPerDay is the total amount of seconds in a day.
+const secondsPerDay = 24 * 60 * 60
+
+// day is the length of a day as a time.Duration.
+const day = secondsPerDay * time.Second
+
+// Buckets returns the upper boundaries, in seconds into the day, of buckets
+// of the given step width spanning a day.  The boundaries are suitable for
+// histograms that observe Time.Score, such as a prometheus histogram whose
+// implicit +Inf bucket covers the final step of the day.  Steps need not be
+// whole seconds.  Nil is returned if step is not positive.
+func Buckets(step time.Duration) []float64 {
+	if step <= 0 {
+		return nil
+	}
+
+	var bounds []float64
+	for b := step; b < day; b += step {
+		bounds = append(bounds, float64(b)/float64(time.Second))
+	}
+
+	return bounds
+}
+
+// BucketLabels returns the start Time of each bucket of the given step width
+// spanning a day, in the format hh:mm:ss, with a fraction of a second for
+// buckets that do not start on a whole second.  Nil is returned if step is
+// not positive.
+func BucketLabels(step time.Duration) []string {
+	if step <= 0 {
+		return nil
+	}
+
+	var labels []string
+	for b := time.Duration(0); b < day; b += step {
+		start := fromDuration(b)
+		labels = append(labels, start.String())
+	}
+
+	return labels
+}
+
+// BucketLabel returns the label of the bucket of the given step width that
+// the Time falls within, matching the corresponding entry of BucketLabels.
+func (t Time) BucketLabel(step time.Duration) string {
+	if step <= 0 {
+		return t.String()
+	}
+
+	start := fromDuration(t.Normalize().sinceMidnight() / step * step)
+	return start.String()
+}
@@ -0,0 +1,44 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuckets(t *testing.T) {
+	hourly := Buckets(time.Hour)
+	assert.Len(t, hourly, 23)
+	assert.Equal(t, float64(3600), hourly[0])
+	assert.Equal(t, float64(23*3600), hourly[22])
+	assert.Nil(t, Buckets(0))
+
+	labels := BucketLabels(15 * time.Minute)
+	assert.Len(t, labels, 96)
+	assert.Equal(t, "00:00:00", labels[0])
+	assert.Equal(t, "23:45:00", labels[95])
+
+	tm := NewTime(14, 37, 12)
+	assert.Equal(t, "14:00:00", tm.BucketLabel(time.Hour))
+	assert.Equal(t, "14:30:00", tm.BucketLabel(15*time.Minute))
+}
+
+func TestBucketsSubSecond(t *testing.T) {
+	bounds := Buckets(1500 * time.Millisecond)
+	assert.Len(t, bounds, 57599)
+	assert.Equal(t, 1.5, bounds[0])
+	assert.Equal(t, 3.0, bounds[1])
+	assert.Equal(t, 86398.5, bounds[57598])
+	assert.Nil(t, Buckets(-time.Second))
+
+	labels := BucketLabels(1500 * time.Millisecond)
+	assert.Len(t, labels, 57600)
+	assert.Equal(t, "00:00:01.5", labels[1])
+	assert.Equal(t, "23:59:58.5", labels[57599])
+
+	tm := NewTimeNano(10, 0, 2, int(900*time.Millisecond))
+	assert.Equal(t, "10:00:01.5", tm.BucketLabel(1500*time.Millisecond))
+	assert.Equal(t, "10:00:02.75", tm.BucketLabel(250*time.Millisecond))
+	assert.Equal(t, "10:00:02", NewTime(10, 0, 2).BucketLabel(500*time.Millisecond))
+}
@@ -0,0 +1,66 @@
+package clock
+
+import "fmt"
+
+// AppendSeconds appends the total seconds into the day of each Time in src
+// to dst and returns the extended slice.
+func AppendSeconds(dst []int32, src []Time) []int32 {
+	for _, t := range src {
+		dst = append(dst, int32(t.TotalSeconds()))
+	}
+
+	return dst
+}
+
+// AppendFromSeconds appends the Time referred to by each of the total seconds
+// into the day in src to dst and returns the extended slice.  Values outside
+// of a single day wrap around.
+func AppendFromSeconds(dst []Time, src []int32) []Time {
+	for _, s := range src {
+		dst = append(dst, fromSeconds(int(s)))
+	}
+
+	return dst
+}
+
+// AppendStrings appends the hh:mm:ss representation of each Time in src to
+// dst and returns the extended slice.  The strings share a single backing
+// allocation.
+func AppendStrings(dst []string, src []Time) []string {
+	buf := make([]byte, 0, len(src)*len("hh:mm:ss"))
+	ends := make([]int, len(src))
+	for i, t := range src {
+		buf, _ = t.AppendText(buf)
+		ends[i] = len(buf)
+	}
+
+	all := string(buf)
+	start := 0
+	for _, end := range ends {
+		dst = append(dst, all[start:end])
+		start = end
+	}
+
+	return dst
+}
+
+// AppendParsed appends the parsed Time of each string in src to dst and
+// returns the extended slice.  If a string fails to parse, the error is
+// annotated with its index and dst is returned as it was given.
+func AppendParsed(dst []Time, src []string) ([]Time, error) {
+	n := len(dst)
+	for i, s := range src {
+		tm, ok := parseCanonical([]byte(s))
+		if !ok {
+			parsed, err := ParseTime(s)
+			if err != nil {
+				return dst[:n], fmt.Errorf("value %d: %w", i, err)
+			}
+			tm = *parsed
+		}
+
+		dst = append(dst, tm)
+	}
+
+	return dst, nil
+}
@@ -0,0 +1,78 @@
+package clock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkConversions(t *testing.T) {
+	times := []Time{NewTime(0, 0, 1), NewTime(12, 30, 0), EndOfDayTime}
+
+	seconds := AppendSeconds(nil, times)
+	assert.Equal(t, []int32{1, 45000, 86399}, seconds)
+	assert.Equal(t, times, AppendFromSeconds(nil, seconds))
+
+	strs := AppendStrings(nil, times)
+	assert.Equal(t, []string{"00:00:01", "12:30:00", "23:59:59"}, strs)
+
+	parsed, err := AppendParsed(nil, strs)
+	assert.Nil(t, err)
+	assert.Equal(t, times, parsed)
+
+	parsed, err = AppendParsed(parsed, []string{"01:00:00", "bad"})
+	assert.ErrorIs(t, err, ErrInvalidTimeFormat)
+	assert.Equal(t, times, parsed)
+}
+
+func benchmarkTimes() []Time {
+	times := make([]Time, 4096)
+	for i := range times {
+		times[i] = fromSeconds(i * 21)
+	}
+
+	return times
+}
+
+func BenchmarkAppendStrings(b *testing.B) {
+	times := benchmarkTimes()
+	dst := make([]string, 0, len(times))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = AppendStrings(dst[:0], times)
+	}
+}
+
+func BenchmarkStringsNaive(b *testing.B) {
+	times := benchmarkTimes()
+	dst := make([]string, 0, len(times))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = dst[:0]
+		for j := range times {
+			dst = append(dst, times[j].String())
+		}
+	}
+}
+
+func BenchmarkAppendParsed(b *testing.B) {
+	strs := AppendStrings(nil, benchmarkTimes())
+	dst := make([]Time, 0, len(strs))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst, _ = AppendParsed(dst[:0], strs)
+	}
+}
+
+func BenchmarkParsedNaive(b *testing.B) {
+	strs := AppendStrings(nil, benchmarkTimes())
+	dst := make([]Time, 0, len(strs))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = dst[:0]
+		for _, s := range strs {
+			tm, _ := ParseTime(s)
+			dst = append(dst, *tm)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package clock
+
+import "time"
+
+// BulkConverter converts many Times occurring on a single date in a source
+// timezone into a set of target timezones.  Each zone's offset is computed
+// once when the converter is created, so converting a Time only requires
+// integer arithmetic.  Zones with an offset change during the date fall back
+// to the same computation as InZones.
+type BulkConverter struct {
+	source  *time.Location
+	date    time.Time
+	offset  int
+	exact   bool
+	targets []zoneOffset
+}
+
+type zoneOffset struct {
+	loc    *time.Location
+	zone   string
+	offset int
+	exact  bool
+}
+
+// NewBulkConverter returns a BulkConverter for Times occurring on the given
+// date in the source timezone.  If an invalid timezone is given, then UTC is
+// used.
+func NewBulkConverter(timezone string, date time.Time, targets ...string) *BulkConverter {
+	source := loadTimeZone(timezone)
+	day := date.In(source)
+	first := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, source)
+	last := time.Date(day.Year(), day.Month(), day.Day(), 23, 59, 59, 0, source)
+
+	_, firstOffset := first.Zone()
+	_, lastOffset := last.Zone()
+
+	c := &BulkConverter{
+		source:  source,
+		date:    first,
+		offset:  firstOffset,
+		exact:   firstOffset == lastOffset,
+		targets: make([]zoneOffset, len(targets)),
+	}
+
+	for i, target := range targets {
+		loc := loadTimeZone(target)
+		zone, startOffset := first.In(loc).Zone()
+		_, endOffset := last.In(loc).Zone()
+
+		c.targets[i] = zoneOffset{
+			loc:    loc,
+			zone:   zone,
+			offset: startOffset,
+			exact:  startOffset == endOffset,
+		}
+	}
+
+	return c
+}
+
+// Convert appends the wall clock equivalent of the Time within each of the
+// target timezones to dst, in the order the targets were given, and returns
+// the extended slice.
+func (c *BulkConverter) Convert(t Time, dst []ZoneTime) []ZoneTime {
+	for i := range c.targets {
+		dst = append(dst, c.ConvertTo(i, t))
+	}
+
+	return dst
+}
+
+// ConvertTo returns the wall clock equivalent of the Time within the target
+// timezone of the given index.
+func (c *BulkConverter) ConvertTo(i int, t Time) ZoneTime {
+	target := c.targets[i]
+	if !c.exact || !target.exact {
+		return zoneTimeOf(t.on(c.date, c.source), target.loc)
+	}
+
+	local := t.sinceMidnight() + time.Duration(target.offset-c.offset)*time.Second
+	days := int(local / day)
+	if local < 0 {
+		days--
+	}
+
+	return ZoneTime{
+		Location:  target.loc,
+		Zone:      target.zone,
+		Time:      fromDuration(local),
+		DayOffset: days,
+	}
+}
@@ -0,0 +1,59 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkConverter(t *testing.T) {
+	targets := []string{"UTC", "America/Los_Angeles", "Europe/Berlin", "Asia/Kolkata", "Pacific/Chatham"}
+
+	for _, date := range []time.Time{
+		time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+		// Daylight saving time starts in New York and ends in Chatham.
+		time.Date(2021, 3, 14, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 4, 4, 0, 0, 0, 0, time.UTC),
+	} {
+		converter := NewBulkConverter("America/New_York", date, targets...)
+		for s := 0; s < secondsPerDay; s += 7 * 60 {
+			tm := fromSeconds(s)
+			expected := tm.InZones("America/New_York", date, targets...)
+			assert.Equal(t, expected, converter.Convert(tm, nil), tm.String())
+
+			tm = NewTimeNano(tm.Hour(), tm.Minute(), tm.Second(), 250*int(time.Millisecond))
+			expected = tm.InZones("America/New_York", date, targets...)
+			assert.Equal(t, expected, converter.Convert(tm, nil), tm.String())
+		}
+	}
+}
+
+func TestBulkConverterFallbackAllocations(t *testing.T) {
+	// Daylight saving time starts in New York, so every conversion takes the
+	// fallback path.  It must reuse the loaded locations rather than loading
+	// them again.
+	date := time.Date(2021, 3, 14, 0, 0, 0, 0, time.UTC)
+	targets := []string{"UTC", "Europe/Berlin"}
+	converter := NewBulkConverter("America/New_York", date, targets...)
+	dst := make([]ZoneTime, 0, len(targets))
+	tm := NewTime(14, 30, 0)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		dst = converter.Convert(tm, dst[:0])
+	})
+	assert.Equal(t, 0.0, allocs)
+}
+
+func BenchmarkBulkConverter(b *testing.B) {
+	date := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	targets := []string{"UTC", "America/Los_Angeles", "Europe/Berlin", "Asia/Tokyo"}
+	converter := NewBulkConverter("America/New_York", date, targets...)
+	dst := make([]ZoneTime, 0, len(targets))
+	tm := NewTime(14, 30, 0)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = converter.Convert(tm, dst[:0])
+	}
+}
@@ -0,0 +1,42 @@
+package clock
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	stringCache     atomic.Value
+	stringCacheOnce sync.Once
+)
+
+// EnableStringCache precomputes the hh:mm:ss representation of every Time in
+// the day, about 700KB of memory, so that String and AppendText become a
+// lookup that does not allocate.  It is safe to call from multiple
+// goroutines and only builds the cache once.
+func EnableStringCache() {
+	stringCacheOnce.Do(func() {
+		buf := make([]byte, 0, secondsPerDay*len("hh:mm:ss"))
+		for s := 0; s < secondsPerDay; s++ {
+			buf, _ = fromSeconds(s).AppendText(buf)
+		}
+
+		stringCache.Store(string(buf))
+	})
+}
+
+// cachedString returns the cached representation of the Time if the cache is
+// enabled and the Time is a whole second within a single day.
+func (t Time) cachedString() (string, bool) {
+	cache, ok := stringCache.Load().(string)
+	if !ok || cache == "" {
+		return "", false
+	}
+
+	if t.nanoseconds != 0 || t.hours < 0 || t.hours > 23 || t.minutes < 0 || t.minutes > 59 || t.seconds < 0 || t.seconds > 59 {
+		return "", false
+	}
+
+	i := t.TotalSeconds() * len("hh:mm:ss")
+	return cache[i : i+len("hh:mm:ss")], true
+}
@@ -0,0 +1,54 @@
+package clock
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// resetStringCache disables the cache again, so that tests enabling it do
+// not affect the tests that run after them.
+func resetStringCache() {
+	stringCache.Store("")
+	stringCacheOnce = sync.Once{}
+}
+
+func TestStringCache(t *testing.T) {
+	uncached := make([]string, secondsPerDay)
+	for s := range uncached {
+		tm := fromSeconds(s)
+		uncached[s] = tm.String()
+	}
+
+	t.Cleanup(resetStringCache)
+	EnableStringCache()
+	EnableStringCache()
+
+	for s, expected := range uncached {
+		tm := fromSeconds(s)
+		if tm.String() != expected {
+			t.Fatalf("cached string %q does not match %q", tm.String(), expected)
+		}
+	}
+
+	outOfRange := NewTime(25, 0, 0)
+	assert.Equal(t, "25:00:00", outOfRange.String())
+
+	fractional := NewTimeNano(12, 34, 56, 500000000)
+	assert.Equal(t, "12:34:56.5", fractional.String())
+
+	tm := NewTime(12, 34, 56)
+	buf := make([]byte, 0, 16)
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = tm.String()
+		buf, _ = tm.AppendText(buf[:0])
+	})
+	assert.Equal(t, 0.0, allocs)
+	assert.Equal(t, "12:34:56", string(buf))
+
+	resetStringCache()
+	_, ok := tm.cachedString()
+	assert.False(t, ok)
+	assert.Equal(t, "12:34:56", tm.String())
+}
@@ -0,0 +1,127 @@
+// Package clockcsv provides helpers for reading CSV data containing
+// columns of clock times.
+package clockcsv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/hypnobrando/clock"
+)
+
+// ParseError is returned when a Time column of a record fails to parse.
+// Row, Line, and Column are all 1-indexed.  Row counts records, including
+// any header, while Line is the line of the input the field starts on,
+// which differs from Row when quoted fields span lines.  Line is zero when
+// built with Go versions before 1.17.
+type ParseError struct {
+	Row    int
+	Line   int
+	Column int
+	Value  string
+	Err    error
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("row %d, column %d: parsing %q: %v", e.Row, e.Column, e.Value, e.Err)
+	}
+
+	return fmt.Sprintf("line %d, column %d: parsing %q: %v", e.Line, e.Column, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying parse error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Reader reads records from a CSV source and parses the configured columns
+// as clock times.
+type Reader struct {
+	// Parse is used to parse each Time column.  It defaults to
+	// clock.ParseTime and can be replaced to support alternate layouts.
+	Parse func(string) (*clock.Time, error)
+
+	// Header skips the first record, which holds the column names rather
+	// than times.
+	Header bool
+
+	csv     *csv.Reader
+	columns []int
+	row     int
+}
+
+// NewReader returns a Reader parsing the given 0-indexed columns of each
+// record read from r as clock times.
+func NewReader(r *csv.Reader, columns ...int) *Reader {
+	return &Reader{
+		Parse:   clock.ParseTime,
+		csv:     r,
+		columns: columns,
+	}
+}
+
+// Read reads the next record and returns it along with the parsed times,
+// in the order the columns were given to NewReader.  At the end of the
+// input io.EOF is returned.  If a column fails to parse a *ParseError is
+// returned.
+func (r *Reader) Read() ([]string, []clock.Time, error) {
+	record, err := r.csv.Read()
+	if err == nil && r.Header && r.row == 0 {
+		r.row++
+		record, err = r.csv.Read()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	r.row++
+
+	times := make([]clock.Time, len(r.columns))
+	for i, column := range r.columns {
+		if column < 0 || column >= len(record) {
+			return record, nil, &ParseError{
+				Row:    r.row,
+				Line:   fieldLine(r.csv, 0),
+				Column: column + 1,
+				Err:    fmt.Errorf("record has %d columns", len(record)),
+			}
+		}
+
+		tm, err := r.Parse(record[column])
+		if err != nil {
+			return record, nil, &ParseError{
+				Row:    r.row,
+				Line:   fieldLine(r.csv, column),
+				Column: column + 1,
+				Value:  record[column],
+				Err:    err,
+			}
+		}
+
+		times[i] = *tm
+	}
+
+	return record, times, nil
+}
+
+// ReadColumn reads every record from r and returns the parsed times of the
+// given 0-indexed column.  If header is true the first record is skipped.
+func ReadColumn(r io.Reader, column int, header bool) ([]clock.Time, error) {
+	reader := NewReader(csv.NewReader(r), column)
+	reader.Header = header
+
+	var times []clock.Time
+	for {
+		_, tt, err := reader.Read()
+		if err == io.EOF {
+			return times, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		times = append(times, tt[0])
+	}
+}
@@ -0,0 +1,77 @@
+package clockcsv
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/hypnobrando/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReader(t *testing.T) {
+	data := "trip,arrival,departure\n" +
+		"a,08:00:00,08:01:30\n" +
+		"b,09:15:00,nope\n"
+
+	reader := NewReader(csv.NewReader(strings.NewReader(data)), 1, 2)
+
+	_, _, err := reader.Read()
+	var parseErr *ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 1, parseErr.Row)
+	assert.Equal(t, 1, parseErr.Line)
+	assert.Equal(t, 2, parseErr.Column)
+
+	record, times, err := reader.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, "a", record[0])
+	assert.Equal(t, []clock.Time{clock.NewTime(8, 0, 0), clock.NewTime(8, 1, 30)}, times)
+
+	_, _, err = reader.Read()
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 3, parseErr.Row)
+	assert.Equal(t, 3, parseErr.Line)
+	assert.Equal(t, 3, parseErr.Column)
+	assert.Equal(t, "nope", parseErr.Value)
+	assert.Equal(t, `line 3, column 3: parsing "nope": `+parseErr.Err.Error(), err.Error())
+	assert.True(t, errors.Is(err, clock.ErrInvalidTimeFormat))
+
+	_, _, err = reader.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestReaderHeader(t *testing.T) {
+	data := "trip,arrival\n" +
+		"\"a\nb\",08:00:00\n" +
+		"c,nope\n"
+
+	reader := NewReader(csv.NewReader(strings.NewReader(data)), 1)
+	reader.Header = true
+
+	record, times, err := reader.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, "a\nb", record[0])
+	assert.Equal(t, []clock.Time{clock.NewTime(8, 0, 0)}, times)
+
+	_, _, err = reader.Read()
+	var parseErr *ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 3, parseErr.Row)
+	assert.Equal(t, 4, parseErr.Line)
+}
+
+func TestReadColumn(t *testing.T) {
+	times, err := ReadColumn(strings.NewReader("10:00:00,x\n11:30:00,y\n"), 0, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []clock.Time{clock.NewTime(10, 0, 0), clock.NewTime(11, 30, 0)}, times)
+
+	times, err = ReadColumn(strings.NewReader("time,name\n10:00:00,x\n"), 0, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []clock.Time{clock.NewTime(10, 0, 0)}, times)
+
+	_, err = ReadColumn(strings.NewReader("time,name\n10:00:00,x\n"), 0, false)
+	assert.True(t, errors.Is(err, clock.ErrInvalidTimeFormat))
+}
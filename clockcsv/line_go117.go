@@ -0,0 +1,12 @@
+//go:build go1.17
+
+package clockcsv
+
+import "encoding/csv"
+
+// fieldLine returns the line of the input the field of the last record read
+// starts on.
+func fieldLine(r *csv.Reader, field int) int {
+	line, _ := r.FieldPos(field)
+	return line
+}
@@ -0,0 +1,70 @@
+//go:build js && wasm
+
+package clockjs
+
+import (
+	"syscall/js"
+	"time"
+
+	"github.com/hypnobrando/clock"
+)
+
+// FromDate returns the wall clock Time of a JavaScript Date in the browser's
+// local timezone, including its milliseconds.
+func FromDate(date js.Value) clock.Time {
+	return clock.NewTimeNano(
+		date.Call("getHours").Int(),
+		date.Call("getMinutes").Int(),
+		date.Call("getSeconds").Int(),
+		date.Call("getMilliseconds").Int()*int(time.Millisecond),
+	)
+}
+
+// FromUTCDate returns the wall clock Time of a JavaScript Date in UTC,
+// including its milliseconds.
+func FromUTCDate(date js.Value) clock.Time {
+	return clock.NewTimeNano(
+		date.Call("getUTCHours").Int(),
+		date.Call("getUTCMinutes").Int(),
+		date.Call("getUTCSeconds").Int(),
+		date.Call("getUTCMilliseconds").Int()*int(time.Millisecond),
+	)
+}
+
+// ToDate returns a new JavaScript Date on the same local date as the given
+// Date, with its local time set to the Time.  Fractions of a millisecond are
+// truncated, as a Date cannot hold them.
+func ToDate(t clock.Time, date js.Value) js.Value {
+	h, m, s := t.HoursMinutesSeconds()
+	return js.Global().Get("Date").New(
+		date.Call("getFullYear"),
+		date.Call("getMonth"),
+		date.Call("getDate"),
+		h, m, s, t.Nanosecond()/int(time.Millisecond),
+	)
+}
+
+// FromISOString returns the wall clock Time of an ISO 8601 string, as
+// produced by Date.prototype.toISOString, or of an hh:mm:ss time.
+func FromISOString(str string) (clock.Time, error) {
+	if tt, err := time.Parse(time.RFC3339Nano, str); err == nil {
+		return clock.FromTime(tt), nil
+	}
+
+	tm, err := clock.ParseTime(str)
+	if err != nil {
+		return clock.Time{}, err
+	}
+
+	return *tm, nil
+}
+
+// ToValue returns the Time as a JavaScript string of the form hh:mm:ss.
+func ToValue(t clock.Time) js.Value {
+	return js.ValueOf(t.String())
+}
+
+// FromValue parses a JavaScript string of the form hh:mm:ss into a Time.
+func FromValue(v js.Value) (clock.Time, error) {
+	return FromISOString(v.String())
+}
@@ -0,0 +1,24 @@
+//go:build js && wasm
+
+package clockjs
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/hypnobrando/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDates(t *testing.T) {
+	date := js.Global().Get("Date").New("2021-07-01T14:30:05.250Z")
+	assert.Equal(t, clock.NewTimeNano(14, 30, 5, 250000000), FromUTCDate(date))
+
+	local := ToDate(clock.NewTimeNano(9, 15, 30, 125999999), date)
+	assert.Equal(t, 125, local.Call("getMilliseconds").Int())
+	assert.Equal(t, clock.NewTimeNano(9, 15, 30, 125000000), FromDate(local))
+
+	tm, err := FromISOString("2021-07-01T14:30:05.250Z")
+	assert.Nil(t, err)
+	assert.Equal(t, clock.NewTimeNano(14, 30, 5, 250000000), tm)
+}
@@ -0,0 +1,4 @@
+// Package clockjs converts between clock times and JavaScript values when
+// compiled to WebAssembly with GOOS=js GOARCH=wasm, so that code running in
+// the browser shares the clock package's parsing and arithmetic.
+package clockjs
@@ -0,0 +1,126 @@
+// Package clocksun computes the times of solar events, such as sunrise and
+// sunset, as clock times.  The results can be used as anchors for
+// clock.ParseExpr, so that times such as "sunrise+30m" can be configured.
+//
+// Times are computed with the sunrise equation and are typically accurate to
+// within a couple of minutes, outside of polar latitudes.
+package clocksun
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/hypnobrando/clock"
+)
+
+// ErrNoEvent indicates that the sun does not cross the requested elevation on
+// the given date, as is the case for sunrise during a polar night.
+var ErrNoEvent = errors.New("clocksun: sun does not reach the elevation on this date")
+
+const (
+	// sunriseElevation is the elevation of the center of the sun at sunrise
+	// and sunset, accounting for refraction and the sun's radius.
+	sunriseElevation = -0.833
+
+	// civilElevation is the elevation of the sun at civil dawn and dusk.
+	civilElevation = -6.0
+
+	julian1970 = 2440587.5
+	julian2000 = 2451545.0
+)
+
+// Sunrise returns the Time of sunrise on the date, at the given latitude and
+// longitude in degrees, expressed in the wall clock of the timezone.  If an
+// empty timezone is given, then clock.DefaultLocation is used, and if an
+// invalid timezone is given, then UTC is used.
+func Sunrise(date time.Time, lat, lon float64, timezone string) (clock.Time, error) {
+	rise, _, err := crossings(date, lat, lon, sunriseElevation)
+	if err != nil {
+		return clock.Time{}, err
+	}
+
+	return wallClock(rise, timezone), nil
+}
+
+// Sunset returns the Time of sunset, following the same conventions as
+// Sunrise.
+func Sunset(date time.Time, lat, lon float64, timezone string) (clock.Time, error) {
+	_, set, err := crossings(date, lat, lon, sunriseElevation)
+	if err != nil {
+		return clock.Time{}, err
+	}
+
+	return wallClock(set, timezone), nil
+}
+
+// Dawn returns the Time of civil dawn, when the sun is 6 degrees below the
+// horizon before sunrise, following the same conventions as Sunrise.
+func Dawn(date time.Time, lat, lon float64, timezone string) (clock.Time, error) {
+	rise, _, err := crossings(date, lat, lon, civilElevation)
+	if err != nil {
+		return clock.Time{}, err
+	}
+
+	return wallClock(rise, timezone), nil
+}
+
+// Dusk returns the Time of civil dusk, when the sun is 6 degrees below the
+// horizon after sunset, following the same conventions as Sunrise.
+func Dusk(date time.Time, lat, lon float64, timezone string) (clock.Time, error) {
+	_, set, err := crossings(date, lat, lon, civilElevation)
+	if err != nil {
+		return clock.Time{}, err
+	}
+
+	return wallClock(set, timezone), nil
+}
+
+// crossings returns the instants at which the sun rises above and sets below
+// the given elevation on the date.
+func crossings(date time.Time, lat, lon, elevation float64) (time.Time, time.Time, error) {
+	noon := time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, time.UTC)
+	n := math.Round(float64(noon.Unix())/86400 + julian1970 - julian2000)
+
+	meanNoon := n - lon/360
+	anomaly := math.Mod(357.5291+0.98560028*meanNoon, 360)
+	center := 1.9148*sin(anomaly) + 0.0200*sin(2*anomaly) + 0.0003*sin(3*anomaly)
+	longitude := math.Mod(anomaly+center+180+102.9372, 360)
+	transit := julian2000 + meanNoon + 0.0053*sin(anomaly) - 0.0069*sin(2*longitude)
+
+	declination := math.Asin(sin(longitude) * sin(23.4397))
+	cosHourAngle := (sin(elevation) - sin(lat)*math.Sin(declination)) /
+		(cos(lat) * math.Cos(declination))
+	if cosHourAngle < -1 || cosHourAngle > 1 {
+		return time.Time{}, time.Time{}, ErrNoEvent
+	}
+
+	hourAngle := math.Acos(cosHourAngle) * 180 / math.Pi
+
+	return fromJulian(transit - hourAngle/360), fromJulian(transit + hourAngle/360), nil
+}
+
+func fromJulian(j float64) time.Time {
+	return time.Unix(int64(math.Round((j-julian1970)*86400)), 0)
+}
+
+func wallClock(instant time.Time, timezone string) clock.Time {
+	loc := clock.DefaultLocation()
+	if timezone != "" {
+		if tzLoc, err := time.LoadLocation(timezone); err == nil {
+			loc = tzLoc
+		} else {
+			loc = time.UTC
+		}
+	}
+
+	return clock.FromTime(instant, loc)
+}
+
+func sin(degrees float64) float64 {
+	return math.Sin(degrees * math.Pi / 180)
+}
+
+func cos(degrees float64) float64 {
+	return math.Cos(degrees * math.Pi / 180)
+}
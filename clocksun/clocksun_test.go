@@ -0,0 +1,54 @@
+package clocksun
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hypnobrando/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertNear(t *testing.T, expected, actual clock.Time) {
+	t.Helper()
+
+	d := expected.TotalSeconds() - actual.TotalSeconds()
+	if d < 0 {
+		d = -d
+	}
+
+	assert.True(t, d <= 120, "expected %s, got %s", expected.String(), actual.String())
+}
+
+func TestSunriseSunset(t *testing.T) {
+	// New York on the June solstice.
+	date := time.Date(2021, 6, 21, 0, 0, 0, 0, time.UTC)
+	lat, lon := 40.7128, -74.0060
+
+	rise, err := Sunrise(date, lat, lon, "America/New_York")
+	assert.Nil(t, err)
+	assertNear(t, clock.NewTime(5, 25, 0), rise)
+
+	set, err := Sunset(date, lat, lon, "America/New_York")
+	assert.Nil(t, err)
+	assertNear(t, clock.NewTime(20, 31, 0), set)
+
+	dawn, err := Dawn(date, lat, lon, "America/New_York")
+	assert.Nil(t, err)
+	assertNear(t, clock.NewTime(4, 53, 0), dawn)
+
+	dusk, err := Dusk(date, lat, lon, "America/New_York")
+	assert.Nil(t, err)
+	assertNear(t, clock.NewTime(21, 3, 0), dusk)
+
+	// London on the December solstice.
+	date = time.Date(2021, 12, 21, 0, 0, 0, 0, time.UTC)
+	rise, err = Sunrise(date, 51.5074, -0.1278, "Europe/London")
+	assert.Nil(t, err)
+	assertNear(t, clock.NewTime(8, 4, 0), rise)
+}
+
+func TestPolar(t *testing.T) {
+	// Tromsø has no sunset in midsummer.
+	_, err := Sunset(time.Date(2021, 6, 21, 0, 0, 0, 0, time.UTC), 69.6492, 18.9553, "Europe/Oslo")
+	assert.Equal(t, ErrNoEvent, err)
+}
@@ -0,0 +1,51 @@
+// Package clocktest provides helpers for writing tests against code that
+// uses the clock package.  Range helpers such as MustRange and a fixed Now
+// are not provided: the clock package has no Range type, and clock.Now reads
+// the system clock directly with no hook to replace it.
+package clocktest
+
+import (
+	"testing"
+
+	"github.com/hypnobrando/clock"
+)
+
+// At parses the input string of the form hh:mm:ss and returns the
+// resulting Time.  It panics if the string cannot be parsed, so it should
+// only be used with constant inputs in tests.
+func At(str string) clock.Time {
+	tm, err := clock.ParseTime(str)
+	if err != nil {
+		panic("clocktest: At(" + str + "): " + err.Error())
+	}
+
+	return *tm
+}
+
+// AssertEqualTimes reports a test failure if expected and actual do not
+// refer to the same Time, down to the nanosecond, once normalized.  It
+// returns whether the assertion succeeded.
+func AssertEqualTimes(t testing.TB, expected, actual clock.Time) bool {
+	t.Helper()
+
+	if expected.Normalize() != actual.Normalize() {
+		t.Errorf("expected time %s, got %s", expected.String(), actual.String())
+		return false
+	}
+
+	return true
+}
+
+// AssertWithin reports a test failure if tm does not occur within the start
+// and end range, following the same semantics as Time.Within.  It returns
+// whether the assertion succeeded.
+func AssertWithin(t testing.TB, tm, start, end clock.Time) bool {
+	t.Helper()
+
+	if !tm.Within(start, end) {
+		t.Errorf("expected time %s to be within %s-%s", tm.String(), start.String(), end.String())
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,36 @@
+package clocktest
+
+import (
+	"testing"
+
+	"github.com/hypnobrando/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAt(t *testing.T) {
+	assert.Equal(t, clock.NewTime(14, 30, 0), At("14:30:00"))
+	assert.Panics(t, func() { At("14:30") })
+}
+
+type recorder struct {
+	testing.TB
+	failed bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failed = true
+}
+
+func TestAssertions(t *testing.T) {
+	assert.True(t, AssertEqualTimes(t, At("09:00:00"), clock.NewTime(9, 0, 0)))
+	assert.True(t, AssertWithin(t, At("23:30:00"), At("22:00:00"), At("06:00:00")))
+
+	rec := &recorder{TB: t}
+	assert.False(t, AssertEqualTimes(rec, At("09:00:00"), At("09:00:01")))
+	assert.False(t, AssertEqualTimes(rec, At("09:00:00"), clock.NewTimeNano(9, 0, 0, 1)))
+	assert.True(t, AssertEqualTimes(t, At("25:00:00"), At("01:00:00")))
+	assert.False(t, AssertWithin(rec, At("12:00:00"), At("22:00:00"), At("06:00:00")))
+	assert.True(t, rec.failed)
+}
@@ -0,0 +1,151 @@
+package clocktest
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hypnobrando/clock"
+)
+
+// RandomTime returns a uniformly distributed random Time within the day,
+// including a fraction of a second.
+func RandomTime(rnd *rand.Rand) clock.Time {
+	return clock.NewTimeNano(rnd.Intn(24), rnd.Intn(60), rnd.Intn(60), rnd.Intn(int(time.Second)))
+}
+
+// RandomDuration returns a random duration within a day in either
+// direction.
+func RandomDuration(rnd *rand.Rand) time.Duration {
+	return time.Duration(rnd.Int63n(int64(48*time.Hour))) - 24*time.Hour
+}
+
+// Implementation describes a type holding a clock.Time for InvariantsOf,
+// such as a downstream wrapper around it.  Its values are passed as
+// interface{}.  Add and Within may be nil, in which case the clock.Time
+// methods of the held Time are used.
+type Implementation struct {
+	// New returns a value holding the Time.
+	New func(t clock.Time) interface{}
+
+	// Time returns the Time held by the value.
+	Time func(v interface{}) clock.Time
+
+	// Format returns the text form of the value, and Parse reads it back.
+	Format func(v interface{}) string
+	Parse  func(str string) (interface{}, error)
+
+	// Add returns the value moved by the duration, wrapping around
+	// midnight.
+	Add func(v interface{}, d time.Duration) interface{}
+
+	// Within reports whether the value is within the range from start to
+	// end, which wraps around midnight if end is before start.
+	Within func(v, start, end interface{}) bool
+}
+
+// ClockTime is the Implementation of clock.Time itself, formatted with String
+// and parsed with ParseTime.
+var ClockTime = Implementation{
+	New:  func(t clock.Time) interface{} { return t },
+	Time: func(v interface{}) clock.Time { return v.(clock.Time) },
+	Format: func(v interface{}) string {
+		tm := v.(clock.Time)
+		return tm.String()
+	},
+	Parse: func(str string) (interface{}, error) {
+		tm, err := clock.ParseTime(str)
+		if err != nil {
+			return nil, err
+		}
+
+		return *tm, nil
+	},
+}
+
+// lastNanosecond is the final Time of the day.
+var lastNanosecond = clock.NewTimeNano(23, 59, 59, int(time.Second-1))
+
+func (impl Implementation) add(v interface{}, d time.Duration) interface{} {
+	if impl.Add != nil {
+		return impl.Add(v, d)
+	}
+
+	return impl.New(impl.Time(v).Add(d))
+}
+
+func (impl Implementation) within(v, start, end interface{}) bool {
+	if impl.Within != nil {
+		return impl.Within(v, start, end)
+	}
+
+	return impl.Time(v).Within(impl.Time(start), impl.Time(end))
+}
+
+// Invariants checks the invariants of InvariantsOf for clock.Time itself,
+// also checking that MarshalText and UnmarshalText round trip.
+func Invariants(t testing.TB, rnd *rand.Rand, iterations int) {
+	t.Helper()
+
+	InvariantsOf(t, rnd, iterations, ClockTime)
+
+	for i := 0; i < iterations; i++ {
+		tm := RandomTime(rnd)
+
+		text, _ := tm.MarshalText()
+		var unmarshaled clock.Time
+		if err := unmarshaled.UnmarshalText(text); err != nil || unmarshaled != tm {
+			t.Errorf("UnmarshalText(%q) = %v, %v", text, unmarshaled, err)
+		}
+	}
+}
+
+// InvariantsOf checks properties of the Implementation that callers rely
+// on, over the given number of randomly generated inputs, reporting each
+// violation as a test failure:
+//
+//   - New and the Time accessor return the same Time
+//   - formatting a value and parsing it back returns the same Time
+//   - Add moves the Time the same as clock.Time.Add, and adding the negated
+//     duration undoes it
+//   - Within is true exactly when the Time is after start and no further
+//     from start than end is, measured forwards around the clock so that
+//     ranges may wrap around midnight
+//
+// The random source is seeded by the caller so that failures are
+// reproducible.
+func InvariantsOf(t testing.TB, rnd *rand.Rand, iterations int, impl Implementation) {
+	t.Helper()
+
+	for i := 0; i < iterations; i++ {
+		tm := RandomTime(rnd)
+		v := impl.New(tm)
+		if got := impl.Time(v); got != tm {
+			t.Errorf("Time(New(%s)) = %s", tm.String(), got.String())
+		}
+
+		str := impl.Format(v)
+		if parsed, err := impl.Parse(str); err != nil || impl.Time(parsed) != tm {
+			t.Errorf("Parse(%q) = %v, %v", str, parsed, err)
+		}
+
+		d := RandomDuration(rnd)
+		added := impl.add(v, d)
+		if got, want := impl.Time(added), tm.Add(d); got != want {
+			t.Errorf("%s.Add(%s) = %s, want %s", tm.String(), d, got.String(), want.String())
+		}
+		if got := impl.Time(impl.add(added, -d)); got != tm {
+			t.Errorf("%s.Add(%s).Add(%s) = %s", tm.String(), d, -d, got.String())
+		}
+
+		start, end := RandomTime(rnd), RandomTime(rnd)
+		length, _ := start.Diff(end)
+		for _, point := range []clock.Time{tm, start, end, clock.StartOfDayTime, clock.EndOfDayTime, lastNanosecond} {
+			offset, _ := start.Diff(point)
+			expected := offset > 0 && offset <= length
+			if impl.within(impl.New(point), impl.New(start), impl.New(end)) != expected {
+				t.Errorf("%s.Within(%s, %s) = %v", point.String(), start.String(), end.String(), !expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package clocktest
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/hypnobrando/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvariants(t *testing.T) {
+	Invariants(t, rand.New(rand.NewSource(1)), 10000)
+}
+
+// objectTime is the Implementation of clock.ObjectTime, standing in for a
+// downstream wrapper encoded as JSON.
+var objectTime = Implementation{
+	New:  func(tm clock.Time) interface{} { return clock.ObjectTime{Time: tm} },
+	Time: func(v interface{}) clock.Time { return v.(clock.ObjectTime).Time },
+	Format: func(v interface{}) string {
+		b, _ := json.Marshal(v)
+		return string(b)
+	},
+	Parse: func(str string) (interface{}, error) {
+		var o clock.ObjectTime
+		err := json.Unmarshal([]byte(str), &o)
+		return o, err
+	},
+}
+
+func TestInvariantsOf(t *testing.T) {
+	InvariantsOf(t, rand.New(rand.NewSource(1)), 1000, objectTime)
+
+	// A Within that excludes midnight is caught.
+	broken := objectTime
+	broken.Within = func(v, start, end interface{}) bool {
+		tm := v.(clock.ObjectTime).Time
+		return tm != clock.StartOfDayTime && tm.Within(start.(clock.ObjectTime).Time, end.(clock.ObjectTime).Time)
+	}
+	rec := &recorder{TB: t}
+	InvariantsOf(rec, rand.New(rand.NewSource(1)), 1000, broken)
+	assert.True(t, rec.failed)
+}
@@ -0,0 +1,220 @@
+// Command clockcli is a command-line front-end to the clock package.
+//
+// Usage:
+//
+//	clockcli between <start> <end>
+//	clockcli convert <time> <from-zone> <to-zone>...
+//	clockcli next <time> [--tz zone]
+//	clockcli slots <start>-<end> [--step duration]
+//	clockcli when "<weekday> <time>" [--tz zone]
+//
+// Times may be given as hh:mm:ss or hh:mm.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hypnobrando/clock"
+)
+
+const usage = `usage:
+  clockcli between <start> <end>
+  clockcli convert <time> <from-zone> <to-zone>...
+  clockcli next <time> [--tz zone]
+  clockcli slots <start>-<end> [--step duration]
+  clockcli when "<weekday> <time>" [--tz zone]`
+
+var errUsage = errors.New(usage)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, time.Now()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+}
+
+// run executes the subcommand given by args, writing its output to w.  The
+// current time is passed in so that the output is deterministic in tests.
+func run(args []string, w io.Writer, now time.Time) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "between":
+		return between(args[1:], w)
+	case "convert":
+		return convert(args[1:], w, now)
+	case "next":
+		return next(args[1:], w, now)
+	case "slots":
+		return slots(args[1:], w)
+	case "when":
+		return when(args[1:], w, now)
+	}
+
+	return fmt.Errorf("unknown command %q\n%s", args[0], usage)
+}
+
+func parseTime(str string) (clock.Time, error) {
+	return clock.ParseExpr(str, nil)
+}
+
+func loadLocation(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+
+	return loc, nil
+}
+
+// between prints the duration from start until end.
+func between(args []string, w io.Writer) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+
+	start, err := parseTime(args[0])
+	if err != nil {
+		return err
+	}
+
+	end, err := parseTime(args[1])
+	if err != nil {
+		return err
+	}
+
+	length, _ := start.Diff(end)
+	_, err = fmt.Fprintln(w, clock.FormatDuration(length))
+	return err
+}
+
+// convert prints the time, occurring today in the source zone, within each of
+// the target zones.
+func convert(args []string, w io.Writer, now time.Time) error {
+	if len(args) < 3 {
+		return errUsage
+	}
+
+	tm, err := parseTime(args[0])
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range args[1:] {
+		if _, err := loadLocation(zone); err != nil {
+			return err
+		}
+	}
+
+	for _, zoneTime := range tm.InZones(args[1], now, args[2:]...) {
+		if _, err := fmt.Fprintf(w, "%s\t%s\n", zoneTime.Location, zoneTime.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// next prints the next instant at which the time occurs in the zone.
+func next(args []string, w io.Writer, now time.Time) error {
+	return nextOccurrence("next", args, w, now)
+}
+
+// when prints the next instant at which the weekday and time occur in the
+// zone, as in "when 'tue 14:00' --tz Asia/Tokyo".
+func when(args []string, w io.Writer, now time.Time) error {
+	return nextOccurrence("when", args, w, now)
+}
+
+func nextOccurrence(name string, args []string, w io.Writer, now time.Time) error {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	tz := flags.String("tz", "UTC", "timezone the time is in")
+	if err := flags.Parse(reorder(args)); err != nil || flags.NArg() == 0 {
+		return errUsage
+	}
+
+	loc, err := loadLocation(*tz)
+	if err != nil {
+		return err
+	}
+
+	occurrence, err := clock.NextOccurrenceString(strings.Join(flags.Args(), " "), now, loc)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s (in %s)\n",
+		occurrence.Format(time.RFC3339),
+		clock.FormatDuration(occurrence.Sub(now).Truncate(time.Second)),
+	)
+	return err
+}
+
+// slots prints the start of each slot of the given step within the range.
+func slots(args []string, w io.Writer) error {
+	flags := flag.NewFlagSet("slots", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	step := flags.Duration("step", 30*time.Minute, "width of each slot")
+	if err := flags.Parse(reorder(args)); err != nil || flags.NArg() != 1 {
+		return errUsage
+	}
+
+	bounds := strings.Split(flags.Arg(0), "-")
+	if len(bounds) != 2 {
+		return fmt.Errorf("range %q not in form start-end", flags.Arg(0))
+	}
+
+	start, err := parseTime(bounds[0])
+	if err != nil {
+		return err
+	}
+
+	end, err := parseTime(bounds[1])
+	if err != nil {
+		return err
+	}
+
+	if *step < time.Second {
+		return fmt.Errorf("step %s must be at least 1s", *step)
+	}
+
+	length, _ := start.Diff(end)
+	for offset := time.Duration(0); offset < length; offset += *step {
+		slot := start.Add(offset)
+		if _, err := fmt.Fprintln(w, slot.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reorder moves flags ahead of positional arguments, so that flags may be
+// given after them as in "next 14:00 --tz UTC".
+func reorder(args []string) []string {
+	var flags, positional []string
+	for i := 0; i < len(args); i++ {
+		if strings.HasPrefix(args[i], "-") {
+			flags = append(flags, args[i])
+			if !strings.Contains(args[i], "=") && i+1 < len(args) {
+				flags = append(flags, args[i+1])
+				i++
+			}
+			continue
+		}
+
+		positional = append(positional, args[i])
+	}
+
+	return append(flags, positional...)
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2021, 7, 1, 11, 30, 0, 0, time.UTC)
+
+func runOutput(t *testing.T, args ...string) string {
+	t.Helper()
+
+	var out bytes.Buffer
+	if err := run(args, &out, now); err != nil {
+		t.Fatal(err)
+	}
+
+	return out.String()
+}
+
+func TestBetween(t *testing.T) {
+	assert.Equal(t, "08:00:00\n", runOutput(t, "between", "09:00", "17:00"))
+	assert.Equal(t, "08:15:00\n", runOutput(t, "between", "22:15", "06:30"))
+}
+
+func TestConvert(t *testing.T) {
+	assert.Equal(t,
+		"America/New_York\t03:00:00 EDT\nAsia/Tokyo\t16:00:00 JST\n",
+		runOutput(t, "convert", "09:00", "Europe/Berlin", "America/New_York", "Asia/Tokyo"),
+	)
+
+	assert.NotNil(t, run([]string{"convert", "09:00", "Mars/Olympus", "UTC"}, &bytes.Buffer{}, now))
+}
+
+func TestNext(t *testing.T) {
+	assert.Equal(t, "2021-07-01T14:00:00Z (in 02:30:00)\n", runOutput(t, "next", "14:00", "--tz", "UTC"))
+	assert.Equal(t, "2021-07-02T10:00:00Z (in 22:30:00)\n", runOutput(t, "next", "10:00"))
+	assert.Equal(t, "2021-07-01T14:00:00+02:00 (in 00:30:00)\n", runOutput(t, "next", "--tz=Europe/Berlin", "14:00"))
+}
+
+func TestSlots(t *testing.T) {
+	assert.Equal(t,
+		"09:00:00\n09:30:00\n10:00:00\n10:30:00\n",
+		runOutput(t, "slots", "09:00-11:00"),
+	)
+	assert.Equal(t,
+		"23:00:00\n00:00:00\n",
+		runOutput(t, "slots", "23:00-01:00", "--step", "1h"),
+	)
+	assert.Equal(t,
+		"23:59:58\n23:59:59\n",
+		runOutput(t, "slots", "23:59:58-00:00:00", "--step", "1s"),
+	)
+}
+
+func TestUsage(t *testing.T) {
+	var out bytes.Buffer
+	assert.Equal(t, errUsage, run(nil, &out, now))
+	assert.NotNil(t, run([]string{"bogus"}, &out, now))
+	assert.Equal(t, errUsage, run([]string{"between", "09:00"}, &out, now))
+}
+
+func TestWhen(t *testing.T) {
+	assert.Equal(t, "2021-07-06T14:00:00+09:00 (in 113:30:00)\n", runOutput(t, "when", "tue 14:00", "--tz", "Asia/Tokyo"))
+	assert.Equal(t, "2021-07-06T14:00:00+09:00 (in 113:30:00)\n", runOutput(t, "when", "--tz", "Asia/Tokyo", "tue", "14:00"))
+	assert.NotNil(t, run([]string{"when", "someday 14:00"}, &bytes.Buffer{}, now))
+}
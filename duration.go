@@ -0,0 +1,79 @@
+package clock
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatDuration returns the duration in the form hh:mm:ss, such as
+// "08:15:00".  Hours may exceed 24 and negative durations are prefixed with
+// "-".  Fractions of a second are truncated.
+func FormatDuration(d time.Duration) string {
+	b := make([]byte, 0, len("-hh:mm:ss"))
+
+	seconds := int64(d / time.Second)
+	if seconds < 0 {
+		b = append(b, '-')
+		seconds = -seconds
+	}
+
+	hours := seconds / 3600
+	if hours < 10 {
+		b = append(b, '0')
+	}
+	b = strconv.AppendInt(b, hours, 10)
+	b = append(b, ':')
+	b = appendDigits(b, int(seconds/60%60))
+	b = append(b, ':')
+	b = appendDigits(b, int(seconds%60))
+
+	return string(b)
+}
+
+// ParseClockDuration takes in a string of the format: hh:mm:ss, optionally
+// prefixed with "-", and returns the duration it represents.  Hours may exceed
+// 24.  If the string is not in a valid format ErrInvalidTimeFormat is
+// returned, and if the duration is too long for a time.Duration an error
+// wrapping ErrOutOfRange is returned.
+func ParseClockDuration(str string) (time.Duration, error) {
+	negative := strings.HasPrefix(str, "-")
+	split := strings.Split(strings.TrimPrefix(str, "-"), ":")
+	if len(split) != 3 {
+		return 0, fmt.Errorf("string not in form hh:mm:ss - %w", ErrInvalidTimeFormat)
+	}
+
+	var parts [3]int64
+	for i, part := range split {
+		if part == "" || strings.TrimLeft(part, "0123456789") != "" {
+			return 0, fmt.Errorf("invalid component %q - %w", part, ErrInvalidTimeFormat)
+		}
+
+		n, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%v: %w", err, ErrInvalidTimeFormat)
+		}
+
+		parts[i] = n
+	}
+
+	if parts[1] > 59 || parts[2] > 59 {
+		return 0, fmt.Errorf("string %q out of range - %w", str, ErrInvalidTimeFormat)
+	}
+
+	rest := time.Duration(parts[1])*time.Minute + time.Duration(parts[2])*time.Second
+	if parts[0] > int64((math.MaxInt64-rest)/time.Hour) {
+		return 0, fmt.Errorf("duration %q overflows time.Duration: %w", str, ErrOutOfRange)
+	}
+
+	d := time.Duration(parts[0])*time.Hour +
+		time.Duration(parts[1])*time.Minute +
+		time.Duration(parts[2])*time.Second
+	if negative {
+		d = -d
+	}
+
+	return d, nil
+}
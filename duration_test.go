@@ -0,0 +1,44 @@
+package clock
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDuration(t *testing.T) {
+	assert.Equal(t, "08:15:00", FormatDuration(8*time.Hour+15*time.Minute))
+	assert.Equal(t, "00:00:00", FormatDuration(0))
+	assert.Equal(t, "37:00:05", FormatDuration(37*time.Hour+5*time.Second+900*time.Millisecond))
+	assert.Equal(t, "-01:30:00", FormatDuration(-90*time.Minute))
+	assert.Equal(t, "120:00:00", FormatDuration(120*time.Hour))
+}
+
+func TestParseClockDuration(t *testing.T) {
+	for _, d := range []time.Duration{
+		0,
+		8*time.Hour + 15*time.Minute,
+		37*time.Hour + 5*time.Second,
+		-90 * time.Minute,
+	} {
+		parsed, err := ParseClockDuration(FormatDuration(d))
+		assert.Nil(t, err)
+		assert.Equal(t, d, parsed)
+	}
+
+	for _, input := range []string{"8:15", "08:60:00", "08:00:-1", "--1:00:00", "a:00:00", "08::00"} {
+		_, err := ParseClockDuration(input)
+		assert.ErrorIs(t, err, ErrInvalidTimeFormat, input)
+	}
+
+	d, err := ParseClockDuration("2562047:47:16")
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(math.MaxInt64).Truncate(time.Second), d)
+
+	for _, input := range []string{"2562047:47:17", "2562048:00:00", "-99999999999:00:00", "9223372036854775807:00:00"} {
+		_, err := ParseClockDuration(input)
+		assert.ErrorIs(t, err, ErrOutOfRange, input)
+	}
+}
@@ -0,0 +1,17 @@
+//go:build go1.24
+
+package clock
+
+import "encoding"
+
+var (
+	_ encoding.TextAppender   = Time{}
+	_ encoding.BinaryAppender = Time{}
+)
+
+var (
+	_ encoding.TextAppender = CompactTime{}
+	_ encoding.TextAppender = MinuteTime{}
+	_ encoding.TextAppender = Time12{}
+	_ encoding.TextAppender = ObjectTime{}
+)
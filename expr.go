@@ -0,0 +1,52 @@
+package clock
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseExpr evaluates an expression of a base Time followed by any number of
+// durations to add or subtract, such as "09:00+30m" or "close-1h15m", and
+// returns the resulting Time.  The base is either a time of the form hh:mm:ss
+// or hh:mm, or the name of one of the given anchors.  Durations are in the
+// format accepted by time.ParseDuration and the result wraps around midnight
+// like Add.  If the expression is invalid ErrInvalidTimeFormat is returned.
+func ParseExpr(expr string, anchors map[string]Time) (Time, error) {
+	str := strings.ReplaceAll(expr, " ", "")
+
+	end := strings.IndexAny(str, "+-")
+	if end < 0 {
+		end = len(str)
+	}
+
+	base := str[:end]
+	tm, ok := anchors[base]
+	if !ok {
+		parsed, err := parseTimeOrMinutes(base)
+		if err != nil {
+			return Time{}, fmt.Errorf("expression %q has unknown base %q - %w", expr, base, ErrInvalidTimeFormat)
+		}
+		tm = *parsed
+	}
+
+	for rest := str[end:]; rest != ""; {
+		next := strings.IndexAny(rest[1:], "+-") + 1
+		if next == 0 {
+			next = len(rest)
+		}
+
+		d, err := time.ParseDuration(rest[1:next])
+		if err != nil {
+			return Time{}, fmt.Errorf("expression %q: %v - %w", expr, err, ErrInvalidTimeFormat)
+		}
+
+		if rest[0] == '-' {
+			d = -d
+		}
+		tm = tm.Add(d)
+		rest = rest[next:]
+	}
+
+	return tm, nil
+}
@@ -0,0 +1,35 @@
+package clock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExpr(t *testing.T) {
+	anchors := map[string]Time{
+		"open":  NewTime(9, 0, 0),
+		"close": NewTime(17, 30, 0),
+	}
+
+	cases := map[string]Time{
+		"09:00+30m":        NewTime(9, 30, 0),
+		"close-1h":         NewTime(16, 30, 0),
+		"open - 15m":       NewTime(8, 45, 0),
+		"close+1h-15m+30s": NewTime(18, 15, 30),
+		"open":             NewTime(9, 0, 0),
+		"23:30:00+1h":      NewTime(0, 30, 0),
+		"close-1h30m":      NewTime(16, 0, 0),
+	}
+	for expr, expected := range cases {
+		tm, err := ParseExpr(expr, anchors)
+		if assert.Nil(t, err, expr) {
+			assert.Equal(t, expected, tm, expr)
+		}
+	}
+
+	for _, expr := range []string{"lunch+1h", "open+", "open+1x", "+1h", "open++1h"} {
+		_, err := ParseExpr(expr, anchors)
+		assert.ErrorIs(t, err, ErrInvalidTimeFormat, expr)
+	}
+}
@@ -0,0 +1,123 @@
+package clock
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ExtendedTime is a Time that may extend past the end of the day, such as
+// the GTFS stop_times value 25:30:00 which refers to 01:30:00 on the day
+// following the service day.
+type ExtendedTime struct {
+	days int
+	time Time
+}
+
+// NewExtendedTime returns a new ExtendedTime object given hours, minutes, and
+// seconds where hours may be 24 or greater.
+func NewExtendedTime(h, m, s int) ExtendedTime {
+	return ExtendedTime{
+		days: h / 24,
+		time: NewTime(h%24, m, s),
+	}
+}
+
+// ParseExtendedTime takes in a string of the format: hh:mm:ss where hh may
+// be 24 or greater and returns a parsed ExtendedTime object.  As with
+// ParseTime the seconds may have a fraction, which is kept.  If the string
+// is not in a valid format ErrInvalidTimeFormat is returned.
+func ParseExtendedTime(str string) (*ExtendedTime, error) {
+	tm, err := ParseTime(str)
+	if err != nil {
+		return nil, err
+	}
+
+	h, m, s := tm.HoursMinutesSeconds()
+	if h < 0 || m < 0 || m > 59 || s < 0 || s > 59 {
+		return nil, fmt.Errorf("%q out of range: %w", str, ErrInvalidTimeFormat)
+	}
+
+	et := NewExtendedTime(h, m, s)
+	et.time.nanoseconds = tm.Nanosecond()
+
+	return &et, nil
+}
+
+// Days returns the number of days past the service day that the
+// ExtendedTime occurs on.
+func (e ExtendedTime) Days() int {
+	return e.days
+}
+
+// Time returns the wall clock Time of the ExtendedTime, without the day
+// offset.
+func (e ExtendedTime) Time() Time {
+	return e.time
+}
+
+// TotalSeconds returns the total amount of seconds since the start of the
+// service day, which may be greater than a single day.
+func (e ExtendedTime) TotalSeconds() int {
+	return e.days*secondsPerDay + e.time.TotalSeconds()
+}
+
+// On converts the ExtendedTime into a time.Time relative to the given
+// service day, interpreted in the given timezone.  As in GTFS, the time is
+// measured from 12 hours before noon of the service day rather than from
+// midnight, which differs on days with a daylight saving transition.  If an
+// invalid timezone is given, then UTC is used.
+func (e ExtendedTime) On(serviceDay time.Time, timezone string) time.Time {
+	loc := loadTimeZone(timezone)
+
+	day := serviceDay.In(loc)
+	noon := time.Date(day.Year(), day.Month(), day.Day(), 12, 0, 0, 0, loc)
+	elapsed := time.Duration(e.days)*24*time.Hour + e.time.sinceMidnight()
+
+	return noon.Add(elapsed - 12*time.Hour)
+}
+
+// String returns the string representation of ExtendedTime: hh:mm:ss where
+// hh includes the day offset, followed by the fraction of the second as
+// with Time.String.
+func (e ExtendedTime) String() string {
+	h, m, s := e.time.HoursMinutesSeconds()
+	return fmt.Sprintf(
+		"%s:%s:%s",
+		digitString(e.days*24+h),
+		digitString(m),
+		digitString(s),
+	) + string(e.time.appendFraction(nil, PrecisionAuto))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e ExtendedTime) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *ExtendedTime) UnmarshalText(data []byte) error {
+	et, err := ParseExtendedTime(string(data))
+	if err != nil {
+		return err
+	}
+
+	*e = *et
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e ExtendedTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *ExtendedTime) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+
+	return e.UnmarshalText([]byte(strings.ReplaceAll(string(data), `"`, "")))
+}
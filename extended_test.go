@@ -0,0 +1,50 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtendedTime(t *testing.T) {
+	et, err := ParseExtendedTime("25:30:00")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, et.Days())
+	assert.Equal(t, NewTime(1, 30, 0), et.Time())
+	assert.Equal(t, "25:30:00", et.String())
+	assert.Equal(t, 25*60*60+30*60, et.TotalSeconds())
+
+	et, err = ParseExtendedTime("8:05:00")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, et.Days())
+	assert.Equal(t, "08:05:00", et.String())
+
+	et, err = ParseExtendedTime("25:30:00.25")
+	assert.Nil(t, err)
+	assert.Equal(t, NewTimeNano(1, 30, 0, 250000000), et.Time())
+	assert.Equal(t, "25:30:00.25", et.String())
+	serviceDay := time.Date(2021, 3, 31, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2021, 4, 1, 1, 30, 0, 250000000, time.UTC), et.On(serviceDay, "UTC"))
+
+	_, err = ParseExtendedTime("25:60:00")
+	assert.ErrorIs(t, err, ErrInvalidTimeFormat)
+
+	on := NewExtendedTime(24, 15, 0).On(serviceDay, "UTC")
+	assert.Equal(t, time.Date(2021, 4, 1, 0, 15, 0, 0, time.UTC), on)
+
+	// Daylight saving time starts in New York at 02:00, so the service day
+	// starts at 23:00 the day before.
+	serviceDay = time.Date(2021, 3, 14, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2021, 3, 14, 5, 0, 0, 0, time.UTC), NewExtendedTime(1, 0, 0).On(serviceDay, "America/New_York").UTC())
+	assert.Equal(t, time.Date(2021, 3, 14, 12, 0, 0, 0, time.UTC), NewExtendedTime(8, 0, 0).On(serviceDay, "America/New_York").UTC())
+	assert.Equal(t, time.Date(2021, 3, 15, 5, 30, 0, 0, time.UTC), NewExtendedTime(25, 30, 0).On(serviceDay, "America/New_York").UTC())
+}
+
+func TestExtendedTimeJSON(t *testing.T) {
+	var et ExtendedTime
+	assert.Nil(t, et.UnmarshalJSON([]byte(`"26:00:01"`)))
+	raw, err := et.MarshalJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, `"26:00:01"`, string(raw))
+}
@@ -0,0 +1,40 @@
+package clock
+
+import "regexp"
+
+var timeTokenRegexp = regexp.MustCompile(`\d{1,2}:\d{2}:\d{2}(\.\d{1,9})?`)
+
+// ExtractTimes returns every valid hh:mm:ss token found within the input
+// text, in the order they appear, including any fraction of the second as
+// in hh:mm:ss.fff.  Tokens that are part of a longer run of
+// digits and colons, or whose components are out of range, are ignored.
+func ExtractTimes(line string) []Time {
+	var times []Time
+	for _, loc := range timeTokenRegexp.FindAllStringIndex(line, -1) {
+		start, end := loc[0], loc[1]
+		if start > 0 && isTimeTokenByte(line[start-1]) {
+			continue
+		}
+		if end < len(line) && isTimeTokenByte(line[end]) {
+			continue
+		}
+
+		tm, err := ParseTime(line[start:end])
+		if err != nil {
+			continue
+		}
+
+		h, m, s := tm.HoursMinutesSeconds()
+		if h > 23 || m > 59 || s > 59 {
+			continue
+		}
+
+		times = append(times, *tm)
+	}
+
+	return times
+}
+
+func isTimeTokenByte(b byte) bool {
+	return b == ':' || (b >= '0' && b <= '9')
+}
@@ -0,0 +1,22 @@
+package clock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractTimes(t *testing.T) {
+	line := "shift started 08:00:00, break 12:03:14-12:33:14 (id 123:45:67) at 25:00:00 or 1:02:03.5 until 23:00:00."
+	assert.Equal(t, []Time{
+		NewTime(8, 0, 0),
+		NewTime(12, 3, 14),
+		NewTime(12, 33, 14),
+		NewTimeNano(1, 2, 3, 500000000),
+		NewTime(23, 0, 0),
+	}, ExtractTimes(line))
+
+	assert.Nil(t, ExtractTimes("1:02:03.1234567890"))
+
+	assert.Nil(t, ExtractTimes("no times 10:11 or 10:11:12:13"))
+}
@@ -0,0 +1,158 @@
+package clock
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Precision controls which components are included when formatting a Time.
+type Precision int
+
+const (
+	// PrecisionSeconds formats a Time as hh:mm:ss.
+	PrecisionSeconds Precision = iota
+
+	// PrecisionMinutes formats a Time as hh:mm, dropping the seconds.
+	PrecisionMinutes
+
+	// PrecisionAuto formats a Time as hh:mm when the seconds are zero, as
+	// hh:mm:ss when the fraction of the second is zero, and as hh:mm:ss.fff
+	// with as many digits as needed otherwise.
+	PrecisionAuto
+
+	// PrecisionMilliseconds formats a Time as hh:mm:ss.fff.
+	PrecisionMilliseconds
+
+	// PrecisionMicroseconds formats a Time as hh:mm:ss.ffffff.
+	PrecisionMicroseconds
+
+	// PrecisionNanoseconds formats a Time as hh:mm:ss.fffffffff.
+	PrecisionNanoseconds
+)
+
+// omitsSeconds reports whether the precision formats the Time as hh:mm.
+func (p Precision) omitsSeconds(t Time) bool {
+	return p == PrecisionMinutes || (p == PrecisionAuto && t.seconds == 0 && t.nanoseconds == 0)
+}
+
+// Format returns the string representation of Time at the given precision.
+func (t Time) Format(p Precision) string {
+	if p == PrecisionSeconds {
+		t.nanoseconds = 0
+		return t.String()
+	}
+
+	return string(t.AppendFormat(make([]byte, 0, len("hh:mm:ss.fffffffff")), p))
+}
+
+// AppendFormat is like Format, but appends the representation of the Time to
+// b and returns the extended buffer.
+func (t Time) AppendFormat(b []byte, p Precision) []byte {
+	b = appendDigits(b, t.hours)
+	b = append(b, ':')
+	b = appendDigits(b, t.minutes)
+	if p.omitsSeconds(t) {
+		return b
+	}
+	b = append(b, ':')
+	b = appendDigits(b, t.seconds)
+
+	return t.appendFraction(b, p)
+}
+
+// parseTimeOrMinutes parses strings of the form hh:mm:ss as well as hh:mm,
+// in which case the seconds are zero.
+func parseTimeOrMinutes(str string) (*Time, error) {
+	if strings.Count(str, ":") == 1 {
+		str += ":00"
+	}
+
+	return ParseTime(str)
+}
+
+// CompactTime is a Time that is marshaled without the seconds when they are
+// zero, such as "09:00" rather than "09:00:00".  Both forms are accepted when
+// unmarshaling.
+type CompactTime struct {
+	Time
+}
+
+// AppendText implements the encoding.TextAppender interface, overriding the
+// method of the embedded Time so that both agree with MarshalText.
+func (c CompactTime) AppendText(b []byte) ([]byte, error) {
+	return c.AppendFormat(b, PrecisionAuto), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (c CompactTime) MarshalText() ([]byte, error) {
+	return c.AppendText(nil)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (c *CompactTime) UnmarshalText(data []byte) error {
+	tt, err := parseTimeOrMinutes(string(data))
+	if err != nil {
+		return err
+	}
+
+	c.Time = *tt
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c CompactTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Format(PrecisionAuto))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *CompactTime) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+
+	return c.UnmarshalText([]byte(strings.ReplaceAll(string(data), `"`, "")))
+}
+
+// MinuteTime is a Time that is always marshaled as hh:mm, truncating any
+// seconds.  Both hh:mm and hh:mm:ss are accepted when unmarshaling.
+type MinuteTime struct {
+	Time
+}
+
+// AppendText implements the encoding.TextAppender interface, overriding the
+// method of the embedded Time so that both agree with MarshalText.
+func (m MinuteTime) AppendText(b []byte) ([]byte, error) {
+	return m.AppendFormat(b, PrecisionMinutes), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m MinuteTime) MarshalText() ([]byte, error) {
+	return m.AppendText(nil)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (m *MinuteTime) UnmarshalText(data []byte) error {
+	tt, err := parseTimeOrMinutes(string(data))
+	if err != nil {
+		return err
+	}
+
+	m.Time = *tt
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (m MinuteTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Format(PrecisionMinutes))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *MinuteTime) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+
+	return m.UnmarshalText([]byte(strings.ReplaceAll(string(data), `"`, "")))
+}
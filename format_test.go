@@ -0,0 +1,114 @@
+package clock
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "09:00:00", NewTime(9, 0, 0).Format(PrecisionSeconds))
+	assert.Equal(t, "09:00", NewTime(9, 0, 0).Format(PrecisionAuto))
+	assert.Equal(t, "09:00:30", NewTime(9, 0, 30).Format(PrecisionAuto))
+	assert.Equal(t, "09:00", NewTime(9, 0, 30).Format(PrecisionMinutes))
+}
+
+func TestCompactAndMinuteTimeJSON(t *testing.T) {
+	var body struct {
+		Open  CompactTime `json:"open"`
+		Close CompactTime `json:"close"`
+		Last  MinuteTime  `json:"last"`
+	}
+	err := json.Unmarshal([]byte(`{"open":"09:00","close":"17:30:15","last":"17:15:45"}`), &body)
+	assert.Nil(t, err)
+	assert.Equal(t, NewTime(9, 0, 0), body.Open.Time)
+	assert.Equal(t, NewTime(17, 30, 15), body.Close.Time)
+
+	raw, err := json.Marshal(body)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"open":"09:00","close":"17:30:15","last":"17:15"}`, string(raw))
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"open":"nine"}`), &body))
+}
+
+func TestAppendFormat(t *testing.T) {
+	b := []byte("at ")
+	assert.Equal(t, "at 09:05", string(NewTime(9, 5, 0).AppendFormat(b, PrecisionAuto)))
+	assert.Equal(t, "at 09:05:07", string(NewTime(9, 5, 7).AppendFormat(b, PrecisionAuto)))
+	assert.Equal(t, "at 09:05", string(NewTime(9, 5, 7).AppendFormat(b, PrecisionMinutes)))
+	assert.Equal(t, "at 09:05:00", string(NewTime(9, 5, 0).AppendFormat(b, PrecisionSeconds)))
+}
+
+func TestFractionalSeconds(t *testing.T) {
+	tm, err := ParseTime("10:11:12.345")
+	assert.Nil(t, err)
+	assert.Equal(t, NewTimeNano(10, 11, 12, 345000000), *tm)
+	assert.Equal(t, "10:11:12.345", tm.String())
+
+	tm, err = ParseTime("10:11:12.000001")
+	assert.Nil(t, err)
+	assert.Equal(t, 1000, tm.Nanosecond())
+
+	for _, input := range []string{"10:11:12.", "10:11:12.1234567890", "10:11:12.1a", "10:11:12.-1"} {
+		_, err := ParseTime(input)
+		assert.ErrorIs(t, err, ErrInvalidTimeFormat, input)
+	}
+
+	tm2 := NewTimeNano(9, 5, 0, 120000000)
+	assert.Equal(t, "09:05:00", tm2.Format(PrecisionSeconds))
+	assert.Equal(t, "09:05:00.12", tm2.Format(PrecisionAuto))
+	assert.Equal(t, "09:05:00.120", tm2.Format(PrecisionMilliseconds))
+	assert.Equal(t, "09:05:00.120000", tm2.Format(PrecisionMicroseconds))
+	assert.Equal(t, "09:05:00.120000000", tm2.Format(PrecisionNanoseconds))
+	assert.Equal(t, "09:05:00.000", NewTime(9, 5, 0).Format(PrecisionMilliseconds))
+	assert.Equal(t, "09:05", NewTime(9, 5, 0).Format(PrecisionAuto))
+
+	data, err := json.Marshal(tm2)
+	assert.Nil(t, err)
+	assert.Equal(t, `"09:05:00.12"`, string(data))
+
+	var decoded Time
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, tm2, decoded)
+
+	text, _ := tm2.MarshalText()
+	assert.Nil(t, decoded.UnmarshalText(text))
+	assert.Equal(t, tm2, decoded)
+}
+
+// TestWrapperAppendText checks that the AppendText of each wrapper agrees
+// with its MarshalText, and that the text round trips.
+func TestWrapperAppendText(t *testing.T) {
+	type textCodec interface {
+		AppendText(b []byte) ([]byte, error)
+		MarshalText() ([]byte, error)
+	}
+
+	tm := NewTime(9, 0, 30)
+	cases := []struct {
+		value    textCodec
+		decoded  interface{ UnmarshalText([]byte) error }
+		expected string
+	}{
+		{CompactTime{tm}, &CompactTime{}, "09:00:30"},
+		{CompactTime{NewTime(9, 0, 0)}, &CompactTime{}, "09:00"},
+		{MinuteTime{tm}, &MinuteTime{}, "09:00"},
+		{Time12{tm}, &Time12{}, "09:00:30"},
+		{ObjectTime{tm}, &ObjectTime{}, "09:00:30"},
+	}
+
+	for _, c := range cases {
+		appended, err := c.value.AppendText([]byte("at "))
+		assert.Nil(t, err)
+		assert.Equal(t, "at "+c.expected, string(appended))
+
+		marshaled, err := c.value.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, c.expected, string(marshaled))
+
+		assert.Nil(t, c.decoded.UnmarshalText(marshaled))
+		again, _ := c.decoded.(textCodec).MarshalText()
+		assert.Equal(t, c.expected, string(again))
+	}
+}
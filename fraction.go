@@ -0,0 +1,20 @@
+package clock
+
+import (
+	"math"
+	"time"
+)
+
+// FractionOfDay returns the position of the Time within the day as a value
+// from 0 inclusive to 1 exclusive, where 0.5 refers to 12:00:00.
+func (t Time) FractionOfDay() float64 {
+	return float64(t.sinceMidnight()) / float64(day)
+}
+
+// FromFraction returns the Time at the given position within the day, where
+// 0.5 refers to 12:00:00.  The result is rounded to the nearest nanosecond,
+// and fractions outside of 0 to 1 wrap around, so 1 refers to
+// StartOfDayTime.
+func FromFraction(f float64) Time {
+	return fromDuration(time.Duration(math.Round(f * float64(day))))
+}
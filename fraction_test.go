@@ -0,0 +1,23 @@
+package clock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFractionOfDay(t *testing.T) {
+	assert.Equal(t, 0.0, StartOfDayTime.FractionOfDay())
+	assert.Equal(t, 0.5, NewTime(12, 0, 0).FractionOfDay())
+	assert.Equal(t, 0.75, NewTime(18, 0, 0).FractionOfDay())
+	assert.Equal(t, 0.5+0.5/secondsPerDay, NewTimeNano(12, 0, 0, 500000000).FractionOfDay())
+
+	assert.Equal(t, NewTime(6, 0, 0), FromFraction(0.25))
+	assert.Equal(t, NewTimeNano(12, 0, 1, 500000000), FromFraction(0.5+1.5/secondsPerDay))
+	assert.Equal(t, StartOfDayTime, FromFraction(1))
+	assert.Equal(t, NewTime(18, 0, 0), FromFraction(-0.25))
+
+	for _, tm := range []Time{NewTime(0, 0, 1), NewTime(7, 13, 59), EndOfDayTime, NewTimeNano(7, 13, 59, 123456789)} {
+		assert.Equal(t, tm, FromFraction(tm.FractionOfDay()))
+	}
+}
@@ -0,0 +1,61 @@
+//go:build go1.21
+
+package clock
+
+import (
+	"math"
+	"sort"
+)
+
+// Integer is the set of integer types accepted by the generic constructors.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// NewTimeOf returns a new Time object given hours, minutes, and seconds of
+// any integer type, such as the int32 and uint32 fields of protobuf messages.
+// If any component is out of range an error wrapping ErrOutOfRange is
+// returned.
+func NewTimeOf[T Integer](h, m, s T) (Time, error) {
+	if err := checkRange(clampInt64(h), clampInt64(m), clampInt64(s)); err != nil {
+		return Time{}, err
+	}
+
+	return NewTime(int(h), int(m), int(s)), nil
+}
+
+// FromSeconds returns the Time the given total seconds into the day refers
+// to, such as a seconds-of-day database column.  Values outside of a single
+// day wrap around.
+func FromSeconds[T Integer](s T) Time {
+	if s >= 0 {
+		return fromSeconds(int(uint64(s) % secondsPerDay))
+	}
+
+	return fromSeconds(int(int64(s) % secondsPerDay))
+}
+
+// clampInt64 converts n to an int64, saturating unsigned values that do not
+// fit.
+func clampInt64[T Integer](n T) int64 {
+	if n > 0 && uint64(n) > math.MaxInt64 {
+		return math.MaxInt64
+	}
+
+	return int64(n)
+}
+
+// SortedKeys returns the keys of the map in order from midnight, such as for
+// iterating over a map[Time]V in a stable order.
+func SortedKeys[V any](m map[Time]V) []Time {
+	keys := make([]Time, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Compare(keys[j]) < 0
+	})
+
+	return keys
+}
@@ -0,0 +1,46 @@
+//go:build go1.21
+
+package clock
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTimeOf(t *testing.T) {
+	tm, err := NewTimeOf(int32(14), int32(30), int32(5))
+	assert.Nil(t, err)
+	assert.Equal(t, NewTime(14, 30, 5), tm)
+
+	tm, err = NewTimeOf(uint8(23), uint8(59), uint8(59))
+	assert.Nil(t, err)
+	assert.Equal(t, EndOfDayTime, tm)
+
+	_, err = NewTimeOf(int64(24), 0, 0)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+
+	_, err = NewTimeOf(0, -1, 0)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+
+	_, err = NewTimeOf(uint64(math.MaxUint64), 0, 0)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+}
+
+func TestFromSeconds(t *testing.T) {
+	assert.Equal(t, NewTime(1, 0, 1), FromSeconds(int64(3601)))
+	assert.Equal(t, NewTime(1, 0, 1), FromSeconds(uint32(3601+secondsPerDay)))
+	assert.Equal(t, EndOfDayTime, FromSeconds(int16(-1)))
+	assert.Equal(t, NewTime(7, 0, 15), FromSeconds(uint64(math.MaxUint64)))
+}
+
+func TestSortedKeys(t *testing.T) {
+	m := map[Time]int{
+		NewTime(18, 0, 0): 1,
+		NewTime(9, 0, 0):  2,
+		NewTime(12, 0, 0): 3,
+	}
+	assert.Equal(t, []Time{NewTime(9, 0, 0), NewTime(12, 0, 0), NewTime(18, 0, 0)}, SortedKeys(m))
+	assert.Empty(t, SortedKeys(map[Time]int{}))
+}
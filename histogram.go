@@ -0,0 +1,101 @@
+package clock
+
+import (
+	"math"
+	"time"
+)
+
+// Histogram counts Times into buckets of a fixed width starting at midnight,
+// for analyzing when during the day events occur.
+type Histogram struct {
+	width  int
+	counts []int
+	total  int
+}
+
+// NewHistogram returns an empty Histogram with buckets of the given width.
+// Widths less than one second are treated as one second.  If the width does
+// not divide the day evenly the final bucket is shorter.
+func NewHistogram(width time.Duration) *Histogram {
+	w := int(width / time.Second)
+	if w < 1 {
+		w = 1
+	}
+
+	return &Histogram{
+		width:  w,
+		counts: make([]int, (secondsPerDay+w-1)/w),
+	}
+}
+
+// Add counts the Time within its bucket.
+func (h *Histogram) Add(t Time) {
+	h.counts[fromSeconds(t.TotalSeconds()).TotalSeconds()/h.width]++
+	h.total++
+}
+
+// Counts returns the count of each bucket, in order from midnight.
+func (h *Histogram) Counts() []int {
+	counts := make([]int, len(h.counts))
+	copy(counts, h.counts)
+
+	return counts
+}
+
+// Total returns the number of Times added to the Histogram.
+func (h *Histogram) Total() int {
+	return h.total
+}
+
+// BucketStart returns the Time at which the bucket of the given index starts.
+func (h *Histogram) BucketStart(i int) Time {
+	return fromSeconds(i * h.width)
+}
+
+// Peak returns the start and end of the bucket with the highest count.  Ties
+// are resolved by the earliest bucket, and the end of the final bucket is
+// StartOfDayTime.
+func (h *Histogram) Peak() (Time, Time) {
+	peak := 0
+	for i, count := range h.counts {
+		if count > h.counts[peak] {
+			peak = i
+		}
+	}
+
+	end := (peak + 1) * h.width
+	if end > secondsPerDay {
+		end = secondsPerDay
+	}
+
+	return h.BucketStart(peak), fromSeconds(end)
+}
+
+// Percentile returns the Time by which the given fraction p, from 0 to 1, of
+// the added Times have occurred, interpolated linearly within its bucket.
+// StartOfDayTime is returned if the Histogram is empty.
+func (h *Histogram) Percentile(p float64) Time {
+	if h.total == 0 {
+		return StartOfDayTime
+	}
+
+	target := math.Max(0, math.Min(1, p)) * float64(h.total)
+	cumulative := 0.0
+	for i, count := range h.counts {
+		if count == 0 || cumulative+float64(count) < target {
+			cumulative += float64(count)
+			continue
+		}
+
+		// The last bucket is shorter when the width does not divide the day.
+		width := h.width
+		if rest := secondsPerDay - i*h.width; rest < width {
+			width = rest
+		}
+
+		offset := (target - cumulative) / float64(count) * float64(width)
+		return fromSeconds(i*h.width + int(math.Min(offset, float64(width-1))))
+	}
+
+	return EndOfDayTime
+}
@@ -0,0 +1,50 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram(t *testing.T) {
+	h := NewHistogram(time.Hour)
+	assert.Len(t, h.Counts(), 24)
+	assert.Equal(t, StartOfDayTime, h.Percentile(0.5))
+
+	for _, tm := range []Time{
+		NewTime(8, 15, 0),
+		NewTime(12, 0, 0),
+		NewTime(12, 30, 0),
+		NewTime(12, 59, 59),
+		NewTime(18, 0, 0),
+	} {
+		h.Add(tm)
+	}
+
+	assert.Equal(t, 5, h.Total())
+	counts := h.Counts()
+	assert.Equal(t, 1, counts[8])
+	assert.Equal(t, 3, counts[12])
+	assert.Equal(t, 1, counts[18])
+
+	start, end := h.Peak()
+	assert.Equal(t, NewTime(12, 0, 0), start)
+	assert.Equal(t, NewTime(13, 0, 0), end)
+
+	assert.Equal(t, NewTime(8, 0, 0), h.Percentile(0))
+	assert.Equal(t, NewTime(12, 30, 0), h.Percentile(0.5))
+	assert.Equal(t, NewTime(18, 59, 59), h.Percentile(1))
+}
+
+func TestHistogramUnevenWidth(t *testing.T) {
+	h := NewHistogram(7 * time.Hour)
+	assert.Len(t, h.Counts(), 4)
+
+	h.Add(NewTime(23, 0, 0))
+	start, end := h.Peak()
+	assert.Equal(t, NewTime(21, 0, 0), start)
+	assert.Equal(t, StartOfDayTime, end)
+	assert.Equal(t, EndOfDayTime, h.Percentile(1))
+	assert.Equal(t, NewTime(22, 30, 0), h.Percentile(0.5))
+}
@@ -0,0 +1,26 @@
+//go:build go1.24
+
+package clock
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOmitZeroJSON(t *testing.T) {
+	type body struct {
+		Start *Time `json:"start,omitzero"`
+		End   Time  `json:"end,omitzero"`
+	}
+
+	raw, err := json.Marshal(body{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{}`, string(raw))
+
+	tm := NewTime(9, 0, 0)
+	raw, err = json.Marshal(body{Start: &tm, End: tm})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"start":"09:00:00","end":"09:00:00"}`, string(raw))
+}
@@ -0,0 +1,63 @@
+package clock
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPointerJSON(t *testing.T) {
+	type body struct {
+		Start *Time `json:"start"`
+		End   *Time `json:"end,omitempty"`
+	}
+
+	raw, err := json.Marshal(body{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"start":null}`, string(raw))
+
+	tm := NewTime(9, 0, 0)
+	raw, err = json.Marshal(body{Start: &tm, End: &tm})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"start":"09:00:00","end":"09:00:00"}`, string(raw))
+
+	decoded := body{Start: &tm}
+	assert.Nil(t, json.Unmarshal([]byte(`{"start":null,"end":"10:00:00"}`), &decoded))
+	assert.Nil(t, decoded.Start)
+	assert.Equal(t, NewTime(10, 0, 0), *decoded.End)
+}
+
+func TestNullJSON(t *testing.T) {
+	var body struct {
+		Time     Time         `json:"time"`
+		Extended ExtendedTime `json:"extended"`
+		Compact  CompactTime  `json:"compact"`
+		Object   ObjectTime   `json:"object"`
+		Twelve   Time12       `json:"twelve"`
+	}
+	body.Time = NewTime(1, 2, 3)
+	body.Object.Time = NewTime(4, 5, 6)
+
+	err := json.Unmarshal([]byte(`{"time":null,"extended":null,"compact":null,"object":null,"twelve":null}`), &body)
+	assert.Nil(t, err)
+	assert.Equal(t, NewTime(1, 2, 3), body.Time)
+	assert.Equal(t, NewTime(4, 5, 6), body.Object.Time)
+}
+
+func TestMapKeyJSON(t *testing.T) {
+	capacity := map[Time]int{
+		NewTime(9, 0, 0):   4,
+		NewTime(17, 30, 0): 2,
+	}
+
+	data, err := json.Marshal(capacity)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"09:00:00":4,"17:30:00":2}`, string(data))
+
+	var decoded map[Time]int
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, capacity, decoded)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"9am":1}`), &decoded))
+}
@@ -0,0 +1,370 @@
+package clock
+
+import "strings"
+
+// Locale phrases and parses Times in a particular language.  EnglishLocale,
+// SpanishLocale, GermanLocale, and FrenchLocale are provided, and other
+// languages can be supported by implementing the interface.  A Locale is
+// the Vocabulary for ParseNaturalIn, which understands the whole minute
+// phrases returned by Spoken.
+type Locale interface {
+	Vocabulary
+
+	// Spoken returns a deterministic phrase for the Time in the given style.
+	// The Time is always within a single day.
+	Spoken(t Time, style SpokenStyle) string
+}
+
+var (
+	// EnglishLocale phrases Times in English.
+	EnglishLocale Locale = englishLocale{}
+
+	// SpanishLocale phrases Times in Spanish.
+	SpanishLocale Locale = spanishLocale{}
+
+	// GermanLocale phrases Times in German.
+	GermanLocale Locale = germanLocale{}
+
+	// FrenchLocale phrases Times in French.
+	FrenchLocale Locale = frenchLocale{}
+)
+
+// LookupLocale returns the provided Locale for a language tag such as "en"
+// or "de-AT", matching on the language alone.
+func LookupLocale(tag string) (Locale, bool) {
+	language := strings.ToLower(tag)
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+
+	switch language {
+	case "en":
+		return EnglishLocale, true
+	case "es":
+		return SpanishLocale, true
+	case "de":
+		return GermanLocale, true
+	case "fr":
+		return FrenchLocale, true
+	}
+
+	return nil, false
+}
+
+// localeVocabulary returns words along with the single word numbers from 0
+// to 59 returned by number, in lower case.
+func localeVocabulary(number func(int) string, words MapVocabulary) MapVocabulary {
+	for n := 0; n < 60; n++ {
+		if word := strings.ToLower(number(n)); !strings.Contains(word, " ") {
+			words[word] = Word{WordNumber, n}
+		}
+	}
+
+	return words
+}
+
+var spanishOnes = []string{
+	"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve",
+	"diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete",
+	"dieciocho", "diecinueve", "veinte", "veintiuno", "veintidós", "veintitrés",
+	"veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve",
+}
+
+var spanishTens = []string{"", "", "", "treinta", "cuarenta", "cincuenta"}
+
+// spanishNumber returns the Spanish words for n, from 0 to 59.
+func spanishNumber(n int) string {
+	switch {
+	case n < 30:
+		return spanishOnes[n]
+	case n%10 == 0:
+		return spanishTens[n/10]
+	}
+
+	return spanishTens[n/10] + " y " + spanishOnes[n%10]
+}
+
+// spanishHour returns the Spanish words for an hour with its article, such
+// as "la una" or "las ocho".
+func spanishHour(h int) string {
+	if h == 1 {
+		return "la una"
+	}
+
+	return "las " + spanishNumber(h)
+}
+
+// spanishSeconds returns the Spanish words for a non-zero number of seconds.
+func spanishSeconds(s int) string {
+	if s == 1 {
+		return "un segundo"
+	}
+
+	return spanishNumber(s) + " segundos"
+}
+
+var spanishVocabulary = localeVocabulary(spanishNumber, MapVocabulary{
+	"un": {WordNumber, 1}, "una": {WordNumber, 1},
+	"y": {Kind: WordAnd}, "en": {Kind: WordFiller}, "punto": {Kind: WordOClock},
+	"mañana": {Kind: WordAM}, "madrugada": {Kind: WordAM},
+	"tarde": {Kind: WordPM}, "noche": {Kind: WordPM},
+	"mediodía": {Kind: WordNoon}, "medianoche": {Kind: WordMidnight},
+
+	"la": {Kind: WordFiller}, "las": {Kind: WordFiller}, "de": {Kind: WordFiller},
+	"hora": {Kind: WordFiller}, "horas": {Kind: WordFiller},
+	"minuto": {Kind: WordFiller}, "minutos": {Kind: WordFiller},
+})
+
+type spanishLocale struct{}
+
+// Lookup implements the Vocabulary interface.
+func (spanishLocale) Lookup(word string) (Word, bool) {
+	return spanishVocabulary.Lookup(word)
+}
+
+// Spoken implements the Locale interface.
+func (spanishLocale) Spoken(t Time, style SpokenStyle) string {
+	h, m, s := t.HoursMinutesSeconds()
+
+	var words []string
+	switch style {
+	case SpokenMilitary:
+		words = append(words, spanishHour(h), "horas")
+		if m != 0 {
+			words = append(words, "y", spanishNumber(m), "minutos")
+		}
+	default:
+		switch {
+		case h == 0 && m == 0 && s == 0:
+			return "medianoche"
+		case h == 12 && m == 0 && s == 0:
+			return "mediodía"
+		}
+
+		hour := h % 12
+		if hour == 0 {
+			hour = 12
+		}
+		words = append(words, spanishHour(hour))
+
+		if m == 0 && s == 0 {
+			words = append(words, "en punto")
+		} else if m != 0 {
+			words = append(words, "y", spanishNumber(m))
+		}
+	}
+
+	if s != 0 {
+		words = append(words, "con", spanishSeconds(s))
+	}
+
+	if style != SpokenMilitary {
+		switch {
+		case h < 6:
+			words = append(words, "de la madrugada")
+		case h < 12:
+			words = append(words, "de la mañana")
+		case h < 20:
+			words = append(words, "de la tarde")
+		default:
+			words = append(words, "de la noche")
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+var germanOnes = []string{
+	"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun",
+	"zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn",
+	"achtzehn", "neunzehn",
+}
+
+var germanTens = []string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig"}
+
+// germanNumber returns the German word for n, from 0 to 59.
+func germanNumber(n int) string {
+	switch {
+	case n < 20:
+		return germanOnes[n]
+	case n%10 == 0:
+		return germanTens[n/10]
+	case n%10 == 1:
+		return "einund" + germanTens[n/10]
+	}
+
+	return germanOnes[n%10] + "und" + germanTens[n/10]
+}
+
+// germanHour returns the German words for an hour, such as "ein Uhr".
+func germanHour(h int) string {
+	if h == 1 {
+		return "ein Uhr"
+	}
+
+	return germanNumber(h) + " Uhr"
+}
+
+var germanVocabulary = localeVocabulary(germanNumber, MapVocabulary{
+	"ein": {WordNumber, 1}, "eine": {WordNumber, 1},
+	"morgens": {Kind: WordAM}, "vormittags": {Kind: WordAM},
+	"nachmittags": {Kind: WordPM}, "abends": {Kind: WordPM}, "nachts": {Kind: WordNight},
+	"mittag": {Kind: WordNoon}, "mitternacht": {Kind: WordMidnight},
+
+	"um": {Kind: WordFiller}, "uhr": {Kind: WordFiller},
+	"minute": {Kind: WordFiller}, "minuten": {Kind: WordFiller},
+})
+
+type germanLocale struct{}
+
+// Lookup implements the Vocabulary interface.
+func (germanLocale) Lookup(word string) (Word, bool) {
+	return germanVocabulary.Lookup(word)
+}
+
+// Spoken implements the Locale interface.
+func (germanLocale) Spoken(t Time, style SpokenStyle) string {
+	h, m, s := t.HoursMinutesSeconds()
+
+	var words []string
+	if style != SpokenMilitary {
+		switch {
+		case h == 0 && m == 0 && s == 0:
+			return "Mitternacht"
+		case h == 12 && m == 0 && s == 0:
+			return "Mittag"
+		}
+
+		hour := h % 12
+		if hour == 0 {
+			hour = 12
+		}
+		words = append(words, germanHour(hour))
+	} else {
+		words = append(words, germanHour(h))
+	}
+
+	if m != 0 {
+		words = append(words, germanNumber(m))
+	}
+
+	if s == 1 {
+		words = append(words, "und eine Sekunde")
+	} else if s != 0 {
+		words = append(words, "und", germanNumber(s), "Sekunden")
+	}
+
+	if style != SpokenMilitary {
+		switch {
+		case h < 5 || h >= 22:
+			words = append(words, "nachts")
+		case h < 10:
+			words = append(words, "morgens")
+		case h < 12:
+			words = append(words, "vormittags")
+		case h < 18:
+			words = append(words, "nachmittags")
+		default:
+			words = append(words, "abends")
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+var frenchOnes = []string{
+	"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf",
+	"dix", "onze", "douze", "treize", "quatorze", "quinze", "seize", "dix-sept",
+	"dix-huit", "dix-neuf",
+}
+
+var frenchTens = []string{"", "", "vingt", "trente", "quarante", "cinquante"}
+
+// frenchNumber returns the French words for n, from 0 to 59.
+func frenchNumber(n int) string {
+	switch {
+	case n < 20:
+		return frenchOnes[n]
+	case n%10 == 0:
+		return frenchTens[n/10]
+	case n%10 == 1:
+		return frenchTens[n/10] + " et un"
+	}
+
+	return frenchTens[n/10] + "-" + frenchOnes[n%10]
+}
+
+// frenchHour returns the French words for an hour, such as "une heure" or
+// "vingt et une heures".
+func frenchHour(h int) string {
+	switch {
+	case h == 1:
+		return "une heure"
+	case h == 21:
+		return "vingt et une heures"
+	case h == 0:
+		return "zéro heure"
+	}
+
+	return frenchNumber(h) + " heures"
+}
+
+var frenchVocabulary = localeVocabulary(frenchNumber, MapVocabulary{
+	"une":   {WordNumber, 1},
+	"et":    {Kind: WordAnd},
+	"matin": {Kind: WordAM}, "l'après-midi": {Kind: WordPM}, "après-midi": {Kind: WordPM},
+	"soir": {Kind: WordPM}, "midi": {Kind: WordNoon}, "minuit": {Kind: WordMidnight},
+
+	"à": {Kind: WordFiller}, "du": {Kind: WordFiller}, "de": {Kind: WordFiller},
+	"heure": {Kind: WordFiller}, "heures": {Kind: WordFiller},
+	"minute": {Kind: WordFiller}, "minutes": {Kind: WordFiller},
+})
+
+type frenchLocale struct{}
+
+// Lookup implements the Vocabulary interface.
+func (frenchLocale) Lookup(word string) (Word, bool) {
+	return frenchVocabulary.Lookup(word)
+}
+
+// Spoken implements the Locale interface.
+func (frenchLocale) Spoken(t Time, style SpokenStyle) string {
+	h, m, s := t.HoursMinutesSeconds()
+
+	var words []string
+	period := ""
+	switch {
+	case style == SpokenMilitary:
+		words = append(words, frenchHour(h))
+	case h == 0:
+		words = append(words, "minuit")
+	case h == 12:
+		words = append(words, "midi")
+	default:
+		words = append(words, frenchHour(h%12))
+		switch {
+		case h < 12:
+			period = "du matin"
+		case h < 18:
+			period = "de l'après-midi"
+		default:
+			period = "du soir"
+		}
+	}
+
+	if m != 0 {
+		words = append(words, frenchNumber(m))
+	}
+
+	if s == 1 {
+		words = append(words, "et une seconde")
+	} else if s != 0 {
+		words = append(words, "et", frenchNumber(s), "secondes")
+	}
+
+	if period != "" {
+		words = append(words, period)
+	}
+
+	return strings.Join(words, " ")
+}
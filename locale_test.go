@@ -0,0 +1,78 @@
+package clock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocales(t *testing.T) {
+	cases := []struct {
+		locale   Locale
+		style    SpokenStyle
+		tm       Time
+		expected string
+	}{
+		{SpanishLocale, SpokenTwelveHour, NewTime(20, 30, 0), "las ocho y treinta de la noche"},
+		{SpanishLocale, SpokenTwelveHour, NewTime(13, 0, 0), "la una en punto de la tarde"},
+		{SpanishLocale, SpokenTwelveHour, NewTime(0, 0, 0), "medianoche"},
+		{SpanishLocale, SpokenMilitary, NewTime(14, 45, 0), "las catorce horas y cuarenta y cinco minutos"},
+		{GermanLocale, SpokenTwelveHour, NewTime(20, 30, 0), "acht Uhr dreißig abends"},
+		{GermanLocale, SpokenTwelveHour, NewTime(1, 21, 1), "ein Uhr einundzwanzig und eine Sekunde nachts"},
+		{GermanLocale, SpokenMilitary, NewTime(14, 5, 0), "vierzehn Uhr fünf"},
+		{GermanLocale, SpokenMilitary, NewTime(0, 0, 0), "null Uhr"},
+		{FrenchLocale, SpokenTwelveHour, NewTime(20, 30, 0), "huit heures trente du soir"},
+		{FrenchLocale, SpokenTwelveHour, NewTime(12, 15, 0), "midi quinze"},
+		{FrenchLocale, SpokenMilitary, NewTime(21, 41, 0), "vingt et une heures quarante et un"},
+		{FrenchLocale, SpokenMilitary, NewTime(0, 5, 30), "zéro heure cinq et trente secondes"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, c.tm.SpokenIn(c.locale, c.style))
+	}
+
+	assert.Equal(t, "eight thirty in the evening", NewTime(20, 30, 0).SpokenIn(nil, SpokenTwelveHour))
+}
+
+func TestLocalesUnique(t *testing.T) {
+	for _, locale := range []Locale{EnglishLocale, SpanishLocale, GermanLocale, FrenchLocale} {
+		for _, style := range []SpokenStyle{SpokenTwelveHour, SpokenMilitary} {
+			seen := make(map[string]Time, secondsPerDay)
+			for s := 0; s < secondsPerDay; s++ {
+				tm := fromSeconds(s)
+				phrase := tm.SpokenIn(locale, style)
+				if other, ok := seen[phrase]; ok {
+					t.Fatalf("%q is the phrase for both %s and %s", phrase, other.String(), tm.String())
+				}
+				seen[phrase] = tm
+			}
+		}
+	}
+}
+
+func TestLocalesRoundTrip(t *testing.T) {
+	for _, locale := range []Locale{EnglishLocale, SpanishLocale, GermanLocale, FrenchLocale} {
+		for _, style := range []SpokenStyle{SpokenTwelveHour, SpokenMilitary} {
+			for m := 0; m < 24*60; m++ {
+				tm := NewTime(m/60, m%60, 0)
+				phrase := tm.SpokenIn(locale, style)
+				parsed, err := ParseNaturalIn(phrase, locale)
+				if assert.Nil(t, err, phrase) {
+					assert.Equal(t, tm, *parsed, phrase)
+				}
+			}
+		}
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	locale, ok := LookupLocale("de-AT")
+	assert.True(t, ok)
+	assert.Equal(t, GermanLocale, locale)
+
+	locale, ok = LookupLocale("EN_us")
+	assert.True(t, ok)
+	assert.Equal(t, EnglishLocale, locale)
+
+	_, ok = LookupLocale("ja")
+	assert.False(t, ok)
+}
@@ -0,0 +1,361 @@
+package clock
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WordKind classifies the words understood by ParseNatural.
+type WordKind int
+
+const (
+	// WordFiller is a word that carries no meaning, such as "in" or "the".
+	WordFiller WordKind = iota
+
+	// WordNumber is a number, such as "nine" or "thirty".
+	WordNumber
+
+	// WordHalf refers to thirty minutes, as in "half past nine".
+	WordHalf
+
+	// WordQuarter refers to fifteen minutes, as in "quarter to five".
+	WordQuarter
+
+	// WordPast adds minutes to the following hour.
+	WordPast
+
+	// WordTo subtracts minutes from the following hour.
+	WordTo
+
+	// WordOClock marks an exact hour.
+	WordOClock
+
+	// WordAM marks a time before noon, such as "am" or "morning".
+	WordAM
+
+	// WordPM marks a time after noon, such as "pm" or "evening".
+	WordPM
+
+	// WordNight marks a time at night, which is after noon for hours from
+	// 5 to 11 and before noon otherwise.
+	WordNight
+
+	// WordNoon refers to 12:00:00.
+	WordNoon
+
+	// WordMidnight refers to 00:00:00.
+	WordMidnight
+
+	// WordAnd joins a number of tens to a following digit, as in the Spanish
+	// "treinta y cinco".  It is otherwise ignored.
+	WordAnd
+)
+
+// Word is the meaning of a single word understood by ParseNatural.
+type Word struct {
+	Kind WordKind
+
+	// Value is the numeric value of a WordNumber.
+	Value int
+}
+
+// Vocabulary maps the locale specific words of a natural language time
+// phrase to their meaning, allowing ParseNaturalIn to support languages
+// other than English.  Every Locale is also a Vocabulary.  Words containing
+// a hyphen are looked up whole first, and otherwise split at the hyphens.
+type Vocabulary interface {
+	// Lookup returns the meaning of the lower case word, or false if the
+	// word is not understood.
+	Lookup(word string) (Word, bool)
+}
+
+// MapVocabulary is a Vocabulary backed by a map of lower case words.
+type MapVocabulary map[string]Word
+
+// Lookup implements the Vocabulary interface.
+func (v MapVocabulary) Lookup(word string) (Word, bool) {
+	w, ok := v[word]
+	return w, ok
+}
+
+// English is the Vocabulary used by ParseNatural.
+var English Vocabulary = MapVocabulary{
+	"zero": {WordNumber, 0}, "oh": {WordNumber, 0},
+	"one": {WordNumber, 1}, "two": {WordNumber, 2}, "three": {WordNumber, 3},
+	"four": {WordNumber, 4}, "five": {WordNumber, 5}, "six": {WordNumber, 6},
+	"seven": {WordNumber, 7}, "eight": {WordNumber, 8}, "nine": {WordNumber, 9},
+	"ten": {WordNumber, 10}, "eleven": {WordNumber, 11}, "twelve": {WordNumber, 12},
+	"thirteen": {WordNumber, 13}, "fourteen": {WordNumber, 14}, "fifteen": {WordNumber, 15},
+	"sixteen": {WordNumber, 16}, "seventeen": {WordNumber, 17}, "eighteen": {WordNumber, 18},
+	"nineteen": {WordNumber, 19}, "twenty": {WordNumber, 20}, "thirty": {WordNumber, 30},
+	"forty": {WordNumber, 40}, "fifty": {WordNumber, 50},
+
+	"half": {Kind: WordHalf}, "quarter": {Kind: WordQuarter},
+	"past": {Kind: WordPast}, "after": {Kind: WordPast},
+	"to": {Kind: WordTo}, "till": {Kind: WordTo}, "before": {Kind: WordTo},
+	"o'clock": {Kind: WordOClock}, "oclock": {Kind: WordOClock},
+	"am": {Kind: WordAM}, "a.m.": {Kind: WordAM}, "morning": {Kind: WordAM},
+	"pm": {Kind: WordPM}, "p.m.": {Kind: WordPM}, "afternoon": {Kind: WordPM}, "evening": {Kind: WordPM},
+	"night": {Kind: WordNight}, "tonight": {Kind: WordNight},
+	"noon": {Kind: WordNoon}, "midday": {Kind: WordNoon}, "midnight": {Kind: WordMidnight},
+
+	"a": {Kind: WordFiller}, "at": {Kind: WordFiller}, "in": {Kind: WordFiller},
+	"the": {Kind: WordFiller}, "minute": {Kind: WordFiller}, "minutes": {Kind: WordFiller},
+	"hundred": {Kind: WordFiller}, "hours": {Kind: WordFiller},
+}
+
+// ParseNatural takes in an English phrase such as "half past nine",
+// "quarter to five pm", "9 in the evening", or "9:30 am" and returns the
+// Time it refers to.  If the phrase is not understood ErrInvalidTimeFormat
+// is returned.
+func ParseNatural(str string) (*Time, error) {
+	return ParseNaturalIn(str, English)
+}
+
+// ParseNaturalIn is like ParseNatural, but understands the words of the
+// given Vocabulary.  Numerals such as "9" and "9:30" are always understood.
+func ParseNaturalIn(str string, vocabulary Vocabulary) (*Time, error) {
+	words, err := naturalWords(str, vocabulary)
+	if err != nil {
+		return nil, err
+	}
+
+	p := naturalParser{words: words}
+	tm, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("phrase %q: %v - %w", str, err, ErrInvalidTimeFormat)
+	}
+
+	return &tm, nil
+}
+
+// naturalWord is a Word along with whether it was written as h:mm.
+type naturalWord struct {
+	Word
+	minutes int
+	clock   bool
+}
+
+// naturalFields splits the lower case phrase into words, splitting words
+// containing a hyphen unless the vocabulary knows them whole.
+func naturalFields(str string, vocabulary Vocabulary) []string {
+	var fields []string
+	for _, field := range strings.Fields(strings.ToLower(str)) {
+		field = strings.TrimRight(field, ",")
+		if _, ok := vocabulary.Lookup(field); ok || !strings.Contains(field, "-") {
+			fields = append(fields, field)
+			continue
+		}
+
+		fields = append(fields, strings.FieldsFunc(field, func(r rune) bool { return r == '-' })...)
+	}
+
+	return fields
+}
+
+func naturalWords(str string, vocabulary Vocabulary) ([]naturalWord, error) {
+	var words []naturalWord
+
+	// compound is whether the previous number may be joined to a following
+	// digit, which a filler word in between prevents.
+	compound := false
+	for _, field := range naturalFields(str, vocabulary) {
+		if strings.Contains(field, ":") {
+			tm, err := parseTimeOrMinutes(field)
+			if err != nil {
+				return nil, err
+			}
+
+			h, m, _ := tm.HoursMinutesSeconds()
+			words = append(words, naturalWord{Word: Word{WordNumber, h}, minutes: m, clock: true})
+			compound = false
+			continue
+		}
+
+		if n, ok := parseDigits(field); ok {
+			words = append(words, naturalWord{Word: Word{WordNumber, n}})
+			compound = false
+			continue
+		}
+
+		w, ok := vocabulary.Lookup(field)
+		if !ok {
+			return nil, fmt.Errorf("unknown word %q in %q - %w", field, str, ErrInvalidTimeFormat)
+		}
+		switch w.Kind {
+		case WordFiller:
+			compound = false
+			continue
+		case WordAnd:
+			continue
+		}
+
+		n := len(words)
+		if w.Kind == WordNumber && compound && isCompoundPrefix(words[n-1]) && w.Value > 0 && w.Value < 10 {
+			words[n-1].Value += w.Value
+			compound = false
+			continue
+		}
+
+		words = append(words, naturalWord{Word: w})
+		compound = w.Kind == WordNumber
+	}
+
+	return words, nil
+}
+
+// isCompoundPrefix reports whether w can be followed by a single digit to
+// form a compound number, such as "twenty five" or "oh five".
+func isCompoundPrefix(w naturalWord) bool {
+	if w.Kind != WordNumber || w.clock {
+		return false
+	}
+
+	return w.Value == 0 || (w.Value >= 20 && w.Value%10 == 0)
+}
+
+func parseDigits(str string) (int, bool) {
+	if str == "" || len(str) > 2 {
+		return 0, false
+	}
+
+	n := 0
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return 0, false
+		}
+		n = n*10 + int(str[i]-'0')
+	}
+
+	return n, true
+}
+
+type naturalParser struct {
+	words []naturalWord
+	pos   int
+}
+
+func (p *naturalParser) peek() (naturalWord, bool) {
+	if p.pos >= len(p.words) {
+		return naturalWord{}, false
+	}
+
+	return p.words[p.pos], true
+}
+
+func (p *naturalParser) accept(kind WordKind) (naturalWord, bool) {
+	w, ok := p.peek()
+	if !ok || w.Kind != kind {
+		return naturalWord{}, false
+	}
+	p.pos++
+
+	return w, true
+}
+
+func (p *naturalParser) parse() (Time, error) {
+	if len(p.words) == 0 {
+		return Time{}, fmt.Errorf("empty phrase")
+	}
+
+	// An offset of minutes relative to the hour, as in "quarter to five".
+	offset, relative := 0, false
+	if w, ok := p.peek(); ok && p.pos+1 < len(p.words) {
+		next := p.words[p.pos+1].Kind
+		if next == WordPast || next == WordTo {
+			switch {
+			case w.Kind == WordHalf:
+				offset = 30
+			case w.Kind == WordQuarter:
+				offset = 15
+			case w.Kind == WordNumber && !w.clock && w.Value > 0 && w.Value < 60:
+				offset = w.Value
+			default:
+				return Time{}, fmt.Errorf("unexpected word before past/to")
+			}
+			if next == WordTo {
+				offset = -offset
+			}
+			relative = true
+			p.pos += 2
+		}
+	}
+
+	var hours, minutes int
+	switch w, _ := p.peek(); w.Kind {
+	case WordNoon, WordMidnight:
+		p.pos++
+		if w.Kind == WordNoon {
+			hours = 12
+		}
+		if relative {
+			total := (hours*60 + offset + 24*60) % (24 * 60)
+			hours, minutes = total/60, total%60
+		} else if m, ok := p.accept(WordNumber); ok {
+			// Minutes may follow, as in the French "midi quinze".
+			if m.clock || m.Value > 59 {
+				return Time{}, fmt.Errorf("invalid minutes")
+			}
+			minutes = m.Value
+		}
+		if _, ok := p.peek(); ok {
+			return Time{}, fmt.Errorf("unexpected words after noon/midnight")
+		}
+
+		return NewTime(hours, minutes, 0), nil
+	case WordNumber:
+		p.pos++
+		if relative && w.clock {
+			return Time{}, fmt.Errorf("minutes given twice")
+		}
+
+		hours, minutes = w.Value, w.minutes
+		if !relative && !w.clock {
+			if m, ok := p.accept(WordNumber); ok {
+				if m.clock || m.Value > 59 {
+					return Time{}, fmt.Errorf("invalid minutes")
+				}
+				minutes = m.Value
+			}
+		}
+	default:
+		return Time{}, fmt.Errorf("expected an hour")
+	}
+
+	p.accept(WordOClock)
+
+	meridiem := WordFiller
+	if w, ok := p.peek(); ok && (w.Kind == WordAM || w.Kind == WordPM || w.Kind == WordNight) {
+		meridiem = w.Kind
+		p.pos++
+	}
+
+	if _, ok := p.peek(); ok {
+		return Time{}, fmt.Errorf("unexpected trailing words")
+	}
+
+	if meridiem == WordNight {
+		meridiem = WordAM
+		if hours >= 5 && hours <= 11 {
+			meridiem = WordPM
+		}
+	}
+
+	if meridiem != WordFiller {
+		if hours < 1 || hours > 12 {
+			return Time{}, fmt.Errorf("hour %d invalid with am/pm", hours)
+		}
+		hours %= 12
+		if meridiem == WordPM {
+			hours += 12
+		}
+	}
+
+	if hours > 23 || minutes > 59 {
+		return Time{}, fmt.Errorf("time out of range")
+	}
+
+	total := (hours*60 + minutes + offset + 24*60) % (24 * 60)
+
+	return NewTime(total/60, total%60, 0), nil
+}
@@ -0,0 +1,55 @@
+package clock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNatural(t *testing.T) {
+	cases := map[string]Time{
+		"half past nine":              NewTime(9, 30, 0),
+		"quarter to five pm":          NewTime(16, 45, 0),
+		"9 in the evening":            NewTime(21, 0, 0),
+		"nine o'clock":                NewTime(9, 0, 0),
+		"twenty-five past ten":        NewTime(10, 25, 0),
+		"ten to midnight":             NewTime(23, 50, 0),
+		"noon":                        NewTime(12, 0, 0),
+		"nine oh five in the morning": NewTime(9, 5, 0),
+		"seven thirty pm":             NewTime(19, 30, 0),
+		"9:30 am":                     NewTime(9, 30, 0),
+		"twelve am":                   NewTime(0, 0, 0),
+		"eleven at night":             NewTime(23, 0, 0),
+		"2 at night":                  NewTime(2, 0, 0),
+		"quarter past twelve am":      NewTime(0, 15, 0),
+		"17:45":                       NewTime(17, 45, 0),
+	}
+	for input, expected := range cases {
+		tm, err := ParseNatural(input)
+		if assert.Nil(t, err, input) {
+			assert.Equal(t, expected, *tm, input)
+		}
+	}
+
+	for _, input := range []string{"", "banana", "half nine", "thirteen pm", "half past 9:30", "noon pm", "nine sixty"} {
+		_, err := ParseNatural(input)
+		assert.ErrorIs(t, err, ErrInvalidTimeFormat, input)
+	}
+}
+
+func TestParseNaturalIn(t *testing.T) {
+	german := MapVocabulary{
+		"neun":    {WordNumber, 9},
+		"uhr":     {Kind: WordOClock},
+		"nach":    {Kind: WordPast},
+		"viertel": {Kind: WordQuarter},
+		"abends":  {Kind: WordPM},
+	}
+
+	tm, err := ParseNaturalIn("viertel nach neun abends", german)
+	assert.Nil(t, err)
+	assert.Equal(t, NewTime(21, 15, 0), *tm)
+
+	_, err = ParseNaturalIn("half past nine", german)
+	assert.ErrorIs(t, err, ErrInvalidTimeFormat)
+}
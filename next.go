@@ -0,0 +1,80 @@
+package clock
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// NextOccurrence returns the first instant after from at which the Time
+// occurs in the given location.  If loc is nil, then DefaultLocation is used.
+func (t Time) NextOccurrence(from time.Time, loc *time.Location) time.Time {
+	return t.nextOccurrence(from, loc, nil)
+}
+
+// NextOccurrenceOn returns the first instant after from at which the Time
+// occurs on the given weekday in the given location.  If loc is nil, then
+// DefaultLocation is used.
+func (t Time) NextOccurrenceOn(weekday time.Weekday, from time.Time, loc *time.Location) time.Time {
+	return t.nextOccurrence(from, loc, &weekday)
+}
+
+func (t Time) nextOccurrence(from time.Time, loc *time.Location, weekday *time.Weekday) time.Time {
+	if loc == nil {
+		loc = DefaultLocation()
+	}
+
+	h, m, s := t.HoursMinutesSeconds()
+	local := from.In(loc)
+	for i := 0; ; i++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+i, 0, 0, 0, 0, loc)
+		if weekday != nil && day.Weekday() != *weekday {
+			continue
+		}
+
+		occurrence := time.Date(day.Year(), day.Month(), day.Day(), h, m, s, t.Nanosecond(), loc)
+		if occurrence.After(from) {
+			return occurrence
+		}
+	}
+}
+
+// NextOccurrenceString parses a specification of an optional weekday and a
+// time, such as "mon 09:00", "Tuesday 14:00:00", or "17:30", and returns the
+// first instant after from at which it occurs in the given location.  If loc
+// is nil, then DefaultLocation is used.  If the specification is invalid
+// ErrInvalidTimeFormat is returned.
+func NextOccurrenceString(spec string, from time.Time, loc *time.Location) (time.Time, error) {
+	fields := strings.Fields(strings.ToLower(spec))
+
+	var weekday *time.Weekday
+	switch len(fields) {
+	case 1:
+	case 2:
+		wd, ok := weekdayNames[fields[0]]
+		if !ok {
+			return time.Time{}, fmt.Errorf("unknown weekday %q - %w", fields[0], ErrInvalidTimeFormat)
+		}
+		weekday = &wd
+		fields = fields[1:]
+	default:
+		return time.Time{}, fmt.Errorf("string %q not in form [weekday] hh:mm - %w", spec, ErrInvalidTimeFormat)
+	}
+
+	tm, err := parseTimeOrMinutes(fields[0])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return tm.nextOccurrence(from, loc, weekday), nil
+}
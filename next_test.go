@@ -0,0 +1,40 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextOccurrence(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Thursday 2021-07-01 11:30 UTC is 20:30 in Tokyo.
+	from := time.Date(2021, 7, 1, 11, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2021, 7, 1, 14, 0, 0, 0, time.UTC), NewTime(14, 0, 0).NextOccurrence(from, time.UTC))
+	assert.Equal(t, time.Date(2021, 7, 2, 11, 30, 0, 0, time.UTC), NewTime(11, 30, 0).NextOccurrence(from, time.UTC))
+	assert.Equal(t, time.Date(2021, 7, 6, 14, 0, 0, 0, tokyo), NewTime(14, 0, 0).NextOccurrenceOn(time.Tuesday, from, tokyo))
+	assert.Equal(t, time.Date(2021, 7, 1, 21, 0, 0, 0, tokyo), NewTime(21, 0, 0).NextOccurrenceOn(time.Thursday, from, tokyo))
+	assert.Equal(t, time.Date(2021, 7, 8, 20, 0, 0, 0, tokyo), NewTime(20, 0, 0).NextOccurrenceOn(time.Thursday, from, tokyo))
+
+	precise := NewTimeNano(14, 0, 0, 250*int(time.Millisecond))
+	assert.Equal(t, time.Date(2021, 7, 1, 14, 0, 0, 250*int(time.Millisecond), time.UTC), precise.NextOccurrence(from, time.UTC))
+
+	next, err := NextOccurrenceString("Tue 14:00", from, tokyo)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2021, 7, 6, 14, 0, 0, 0, tokyo), next)
+
+	next, err = NextOccurrenceString("17:30:00", from, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2021, 7, 1, 17, 30, 0, 0, time.UTC), next)
+
+	for _, spec := range []string{"someday 14:00", "mon", "mon 14:00 utc", ""} {
+		_, err = NextOccurrenceString(spec, from, nil)
+		assert.ErrorIs(t, err, ErrInvalidTimeFormat, spec)
+	}
+}
@@ -0,0 +1,28 @@
+//go:build go1.22
+
+package clock
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLNull(t *testing.T) {
+	var null sql.Null[Time]
+	assert.Nil(t, null.Scan(nil))
+	assert.False(t, null.Valid)
+
+	value, err := null.Value()
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+
+	assert.Nil(t, null.Scan([]byte("08:30:00")))
+	assert.True(t, null.Valid)
+	assert.Equal(t, NewTime(8, 30, 0), null.V)
+
+	value, err = null.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "08:30:00", value)
+}
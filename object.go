@@ -0,0 +1,93 @@
+package clock
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ObjectTime is a Time that is encoded in JSON as an object of its
+// components, such as {"hour":14,"minute":30,"second":0}, with a
+// "nanosecond" key only when the fraction of the second is not zero.  When
+// unmarshaling, the keys "hours", "minutes", "seconds", and "nanos" used by
+// google.type.TimeOfDay are also accepted, and components out of range are
+// rejected with an error wrapping ErrOutOfRange.
+type ObjectTime struct {
+	Time
+}
+
+type timeObject struct {
+	Hour       int `json:"hour"`
+	Minute     int `json:"minute"`
+	Second     int `json:"second"`
+	Nanosecond int `json:"nanosecond,omitempty"`
+}
+
+type timeObjectInput struct {
+	Hour    *int `json:"hour"`
+	Minute  *int `json:"minute"`
+	Second  *int `json:"second"`
+	Hours   *int `json:"hours"`
+	Minutes *int `json:"minutes"`
+	Seconds *int `json:"seconds"`
+
+	Nanosecond *int `json:"nanosecond"`
+	Nanos      *int `json:"nanos"`
+}
+
+// AppendText implements the encoding.TextAppender interface.  The text form
+// of an ObjectTime, used for example as a JSON map key, is that of Time.
+func (o ObjectTime) AppendText(b []byte) ([]byte, error) {
+	return o.Time.AppendText(b)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (o ObjectTime) MarshalText() ([]byte, error) {
+	return o.AppendText(nil)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (o ObjectTime) MarshalJSON() ([]byte, error) {
+	h, m, s := o.HoursMinutesSeconds()
+	return json.Marshal(timeObject{Hour: h, Minute: m, Second: s, Nanosecond: o.Nanosecond()})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (o *ObjectTime) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+
+	var input timeObjectInput
+	if err := json.Unmarshal(data, &input); err != nil {
+		return fmt.Errorf("%v: %w", err, ErrInvalidTimeFormat)
+	}
+
+	h := firstInt(input.Hour, input.Hours)
+	m := firstInt(input.Minute, input.Minutes)
+	s := firstInt(input.Second, input.Seconds)
+	if err := checkRange(int64(h), int64(m), int64(s)); err != nil {
+		return err
+	}
+
+	ns := firstInt(input.Nanosecond, input.Nanos)
+	if ns < 0 || ns >= int(time.Second) {
+		return &RangeError{Field: "nanoseconds", Value: int64(ns), Max: int64(time.Second - 1)}
+	}
+
+	o.Time = NewTimeNano(h, m, s, ns)
+
+	return nil
+}
+
+// firstInt returns the value of the first non-nil input, or zero if all of
+// them are nil.
+func firstInt(values ...*int) int {
+	for _, v := range values {
+		if v != nil {
+			return *v
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,52 @@
+package clock
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectTimeJSON(t *testing.T) {
+	var body struct {
+		Start ObjectTime `json:"start"`
+		End   ObjectTime `json:"end"`
+	}
+	err := json.Unmarshal([]byte(`{"start":{"hour":14,"minute":30},"end":{"hours":16,"minutes":5,"seconds":9}}`), &body)
+	assert.Nil(t, err)
+	assert.Equal(t, NewTime(14, 30, 0), body.Start.Time)
+	assert.Equal(t, NewTime(16, 5, 9), body.End.Time)
+
+	raw, err := json.Marshal(body)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"start":{"hour":14,"minute":30,"second":0},"end":{"hour":16,"minute":5,"second":9}}`, string(raw))
+
+	err = json.Unmarshal([]byte(`{"start":"14:30:00"}`), &body)
+	assert.ErrorIs(t, err, ErrInvalidTimeFormat)
+}
+
+func TestObjectTimeNanos(t *testing.T) {
+	var o ObjectTime
+	assert.Nil(t, json.Unmarshal([]byte(`{"hours":9,"minutes":5,"seconds":1,"nanos":500}`), &o))
+	assert.Equal(t, NewTimeNano(9, 5, 1, 500), o.Time)
+
+	raw, err := json.Marshal(o)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"hour":9,"minute":5,"second":1,"nanosecond":500}`, string(raw))
+
+	var decoded ObjectTime
+	assert.Nil(t, json.Unmarshal(raw, &decoded))
+	assert.Equal(t, o, decoded)
+}
+
+func TestObjectTimeRange(t *testing.T) {
+	for _, input := range []string{
+		`{"hour":24}`,
+		`{"hour":10,"minute":60}`,
+		`{"hours":10,"seconds":-1}`,
+		`{"hour":10,"nanos":1000000000}`,
+	} {
+		var o ObjectTime
+		assert.ErrorIs(t, json.Unmarshal([]byte(input), &o), ErrOutOfRange, input)
+	}
+}
@@ -0,0 +1,143 @@
+package clock
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Options configures ParseTimeOpt, FormatOpt, and ScannerWithOptions.  The
+// zero value behaves the same as ParseTime, String, and Time.Scan.
+type Options struct {
+	// Precision is the precision used when formatting.  When parsing,
+	// PrecisionMinutes and PrecisionAuto also accept strings without
+	// seconds.
+	Precision Precision
+
+	// Separator separates the hours, minutes, and seconds, such as '.' for
+	// 10.30.00.  The zero value is ':'.  Only the first two separators are
+	// significant when parsing, so that with '.' a fraction of a second may
+	// still follow, as in 10.30.00.5.
+	Separator byte
+
+	// TwelveHour formats times on a 12-hour clock, such as "2:30 PM", and
+	// also accepts 12-hour strings as parsed by ParseTime12 when parsing.
+	TwelveHour bool
+
+	// Strict rejects parsed times with hours, minutes, or seconds out of
+	// range with an error wrapping ErrOutOfRange.
+	Strict bool
+
+	// RejectNull makes ScannerWithOptions return an error for NULL values
+	// instead of leaving the Time unchanged.
+	RejectNull bool
+}
+
+func (o Options) separator() byte {
+	if o.Separator == 0 {
+		return ':'
+	}
+
+	return o.Separator
+}
+
+// ParseTimeOpt is like ParseTime, but parses according to the given Options.
+func ParseTimeOpt(str string, opts Options) (*Time, error) {
+	if sep := opts.separator(); sep != ':' {
+		str = strings.Replace(str, string(sep), ":", 2)
+	}
+
+	parse := ParseTime
+	if opts.Precision == PrecisionMinutes || opts.Precision == PrecisionAuto {
+		parse = parseTimeOrMinutes
+	}
+
+	tm, err := parse(str)
+	if err != nil && opts.TwelveHour {
+		tm, err = ParseTime12(str)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Strict {
+		h, m, s := tm.HoursMinutesSeconds()
+		if err := checkRange(int64(h), int64(m), int64(s)); err != nil {
+			return nil, fmt.Errorf("time %q: %w", str, err)
+		}
+	}
+
+	return tm, nil
+}
+
+// FormatOpt returns the string representation of the Time according to the
+// given Options.
+func FormatOpt(t Time, opts Options) string {
+	h, m, s := t.HoursMinutesSeconds()
+	sep := opts.separator()
+
+	meridiem := ""
+	if opts.TwelveHour {
+		meridiem = " AM"
+		if h >= 12 {
+			meridiem = " PM"
+		}
+		h %= 12
+		if h == 0 {
+			h = 12
+		}
+	}
+
+	b := make([]byte, 0, len("12:00:00 PM"))
+	if opts.TwelveHour {
+		b = strconv.AppendInt(b, int64(h), 10)
+	} else {
+		b = appendDigits(b, h)
+	}
+	b = append(b, sep)
+	b = appendDigits(b, m)
+	if !opts.Precision.omitsSeconds(t) {
+		b = append(b, sep)
+		b = appendDigits(b, s)
+		b = t.appendFraction(b, opts.Precision)
+	}
+
+	return string(append(b, meridiem...))
+}
+
+// ScannerWithOptions implements the sql.Scanner interface, scanning into
+// Time according to Options.
+type ScannerWithOptions struct {
+	Time    *Time
+	Options Options
+}
+
+// Scan implements the sql.Scanner interface.
+func (s ScannerWithOptions) Scan(src interface{}) error {
+	var str string
+	switch v := src.(type) {
+	case nil:
+		if s.Options.RejectNull {
+			return fmt.Errorf("NULL value - %w", ErrInvalidTimeFormat)
+		}
+		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case time.Time:
+		return s.Time.Scan(v)
+	default:
+		return fmt.Errorf("failed to parse clock.Time from sql driver type %T", src)
+	}
+
+	tm, err := ParseTimeOpt(str, s.Options)
+	if err != nil {
+		return err
+	}
+
+	*s.Time = *tm
+
+	return nil
+}
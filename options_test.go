@@ -0,0 +1,99 @@
+package clock
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeOpt(t *testing.T) {
+	tm, err := ParseTimeOpt("10:11:12", Options{})
+	assert.Nil(t, err)
+	assert.Equal(t, NewTime(10, 11, 12), *tm)
+
+	_, err = ParseTimeOpt("10:11", Options{})
+	assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+
+	tm, err = ParseTimeOpt("10.11", Options{Precision: PrecisionAuto, Separator: '.'})
+	assert.Nil(t, err)
+	assert.Equal(t, NewTime(10, 11, 0), *tm)
+
+	tm, err = ParseTimeOpt("10.11.12.5", Options{Separator: '.'})
+	assert.Nil(t, err)
+	assert.Equal(t, NewTimeNano(10, 11, 12, 500000000), *tm)
+
+	_, err = ParseTimeOpt("10.11.12.5.6", Options{Separator: '.'})
+	assert.True(t, errors.Is(err, ErrInvalidTimeFormat))
+
+	for _, precision := range []Precision{PrecisionMilliseconds, PrecisionMicroseconds, PrecisionNanoseconds} {
+		_, err = ParseTimeOpt("10:11", Options{Precision: precision})
+		assert.True(t, errors.Is(err, ErrInvalidTimeFormat), precision)
+
+		tm, err = ParseTimeOpt("10:11:12", Options{Precision: precision})
+		assert.Nil(t, err)
+		assert.Equal(t, NewTime(10, 11, 12), *tm)
+	}
+
+	tm, err = ParseTimeOpt("2:30 PM", Options{TwelveHour: true})
+	assert.Nil(t, err)
+	assert.Equal(t, NewTime(14, 30, 0), *tm)
+
+	tm, err = ParseTimeOpt("14:30:00", Options{TwelveHour: true})
+	assert.Nil(t, err)
+	assert.Equal(t, NewTime(14, 30, 0), *tm)
+
+	tm, err = ParseTimeOpt("25:00:00", Options{})
+	assert.Nil(t, err)
+	assert.Equal(t, NewTime(25, 0, 0), *tm)
+
+	_, err = ParseTimeOpt("25:00:00", Options{Strict: true})
+	assert.True(t, errors.Is(err, ErrOutOfRange))
+}
+
+func TestFormatOpt(t *testing.T) {
+	tm := NewTime(14, 5, 0)
+	assert.Equal(t, "14:05:00", FormatOpt(tm, Options{}))
+	assert.Equal(t, "14.05", FormatOpt(tm, Options{Precision: PrecisionAuto, Separator: '.'}))
+	assert.Equal(t, "2:05 PM", FormatOpt(tm, Options{Precision: PrecisionMinutes, TwelveHour: true}))
+	assert.Equal(t, "12:00:30 AM", FormatOpt(NewTime(0, 0, 30), Options{Precision: PrecisionAuto, TwelveHour: true}))
+
+	for _, opts := range []Options{
+		{},
+		{Precision: PrecisionAuto, Separator: '.'},
+		{Precision: PrecisionAuto, TwelveHour: true},
+		{Precision: PrecisionNanoseconds, Separator: '.'},
+	} {
+		for _, tm := range []Time{StartOfDayTime, NewTime(12, 0, 0), NewTime(9, 30, 15), EndOfDayTime} {
+			parsed, err := ParseTimeOpt(FormatOpt(tm, opts), opts)
+			assert.Nil(t, err)
+			assert.Equal(t, tm, *parsed, FormatOpt(tm, opts))
+		}
+	}
+
+	opts := Options{Precision: PrecisionNanoseconds, Separator: '.'}
+	tm = NewTimeNano(9, 30, 15, 250000000)
+	assert.Equal(t, "09.30.15.250000000", FormatOpt(tm, opts))
+	parsed, err := ParseTimeOpt(FormatOpt(tm, opts), opts)
+	assert.Nil(t, err)
+	assert.Equal(t, tm, *parsed)
+}
+
+func TestScannerWithOptions(t *testing.T) {
+	tm := NewTime(1, 2, 3)
+	scanner := ScannerWithOptions{Time: &tm, Options: Options{Precision: PrecisionAuto}}
+
+	assert.Nil(t, scanner.Scan(nil))
+	assert.Equal(t, NewTime(1, 2, 3), tm)
+
+	assert.Nil(t, scanner.Scan([]byte("09:30")))
+	assert.Equal(t, NewTime(9, 30, 0), tm)
+
+	assert.Nil(t, scanner.Scan(time.Date(0, 1, 1, 18, 45, 0, 0, time.UTC)))
+	assert.Equal(t, NewTime(18, 45, 0), tm)
+
+	scanner.Options.RejectNull = true
+	assert.True(t, errors.Is(scanner.Scan(nil), ErrInvalidTimeFormat))
+	assert.NotNil(t, scanner.Scan(42))
+}
@@ -0,0 +1,98 @@
+package clock
+
+import "fmt"
+
+// Parser parses times repeatedly with a fixed configuration.  Canonical
+// hh:mm:ss input is parsed directly from bytes without allocating; other
+// input falls back to the same parsing as ParseTime.  The zero value parses
+// the same strings as ParseTime.  A Parser is safe for concurrent use as long
+// as its fields are not modified.
+type Parser struct {
+	// AllowMinutes also accepts strings of the form hh:mm.
+	AllowMinutes bool
+
+	// Allow12Hour also accepts 12-hour strings as parsed by ParseTime12.
+	Allow12Hour bool
+}
+
+// Parse parses a single time from b, such as a line returned from
+// bufio.Scanner.Bytes.
+func (p *Parser) Parse(b []byte) (Time, error) {
+	if tm, ok := parseCanonical(b); ok {
+		return tm, nil
+	}
+
+	str := string(b)
+	parse := ParseTime
+	if p.AllowMinutes {
+		parse = parseTimeOrMinutes
+	}
+
+	tm, err := parse(str)
+	if err != nil && p.Allow12Hour {
+		if tm12, err12 := ParseTime12(str); err12 == nil {
+			return *tm12, nil
+		}
+	}
+	if err != nil {
+		return Time{}, err
+	}
+
+	return *tm, nil
+}
+
+// ParseAll parses each of the values, returning an error annotated with the
+// index of the first value that fails to parse.
+func (p *Parser) ParseAll(values [][]byte) ([]Time, error) {
+	times := make([]Time, len(values))
+	for i, b := range values {
+		tm, err := p.Parse(b)
+		if err != nil {
+			return nil, fmt.Errorf("value %d: %w", i, err)
+		}
+
+		times[i] = tm
+	}
+
+	return times, nil
+}
+
+// parseCanonical parses b if it is exactly of the form h:mm:ss or hh:mm:ss.
+func parseCanonical(b []byte) (Time, bool) {
+	if len(b) != 7 && len(b) != 8 {
+		return Time{}, false
+	}
+
+	i := 0
+	h := 0
+	for ; i < len(b)-6; i++ {
+		if b[i] < '0' || b[i] > '9' {
+			return Time{}, false
+		}
+		h = h*10 + int(b[i]-'0')
+	}
+
+	if b[i] != ':' || b[i+3] != ':' {
+		return Time{}, false
+	}
+
+	m, ok := twoDigits(b[i+1], b[i+2])
+	if !ok {
+		return Time{}, false
+	}
+
+	s, ok := twoDigits(b[i+4], b[i+5])
+	if !ok {
+		return Time{}, false
+	}
+
+	return NewTime(h, m, s), true
+}
+
+func twoDigits(a, b byte) (int, bool) {
+	if a < '0' || a > '9' || b < '0' || b > '9' {
+		return 0, false
+	}
+
+	return int(a-'0')*10 + int(b-'0'), true
+}
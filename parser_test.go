@@ -0,0 +1,152 @@
+package clock
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser(t *testing.T) {
+	var p Parser
+	for _, input := range []string{"10:11:12", "9:05:00", "100:00:00", "+1:02:03", "10:11", "2:30 PM"} {
+		expected, expectedErr := ParseTime(input)
+		tm, err := p.Parse([]byte(input))
+		if expectedErr != nil {
+			assert.NotNil(t, err, input)
+			continue
+		}
+		assert.Nil(t, err, input)
+		assert.Equal(t, *expected, tm, input)
+	}
+
+	p = Parser{AllowMinutes: true, Allow12Hour: true}
+	times, err := p.ParseAll([][]byte{[]byte("10:11"), []byte("2:30 PM"), []byte("23:59:59")})
+	assert.Nil(t, err)
+	assert.Equal(t, []Time{NewTime(10, 11, 0), NewTime(14, 30, 0), EndOfDayTime}, times)
+
+	_, err = p.ParseAll([][]byte{[]byte("10:11"), []byte("nope")})
+	assert.ErrorIs(t, err, ErrInvalidTimeFormat)
+	assert.Contains(t, err.Error(), "value 1")
+}
+
+func TestParserAllocations(t *testing.T) {
+	var p Parser
+	b := []byte("12:34:56")
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = p.Parse(b)
+	})
+	assert.Equal(t, 0.0, allocs)
+}
+
+func BenchmarkParser(b *testing.B) {
+	var p Parser
+	input := []byte("12:34:56")
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Parse(input)
+	}
+}
+
+func BenchmarkParseTime(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseTime("12:34:56")
+	}
+}
+
+// TestParserDifferential checks Parser against time.Parse with the matching
+// layouts, over inputs generated from the characters that appear in times,
+// and over prefixes of a time with a fraction that have a single character
+// replaced.  Inputs that time.Parse rejects must be one of the extended forms
+// ParseTime documents, such as signed or out of range fields, and are checked
+// against extendedReference instead.
+func TestParserDifferential(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const alphabet = "0123456789::..+- "
+	const sample = "23:59:59.1234567890"
+
+	for i := 0; i < 100000; i++ {
+		var b []byte
+		if i%2 == 0 {
+			b = make([]byte, rnd.Intn(10))
+			for j := range b {
+				b[j] = alphabet[rnd.Intn(len(alphabet))]
+			}
+		} else {
+			b = []byte(sample[:1+rnd.Intn(len(sample))])
+			b[rnd.Intn(len(b))] = alphabet[rnd.Intn(len(alphabet))]
+		}
+
+		for _, p := range []Parser{{}, {AllowMinutes: true}} {
+			expected, ok := timeParseReference(string(b), p.AllowMinutes)
+			if !ok {
+				expected, ok = extendedReference(string(b), p.AllowMinutes)
+			}
+
+			tm, err := p.Parse(b)
+			if (err == nil) != ok {
+				t.Fatalf("Parse(%q) with %+v: error %v, reference accepts %v", b, p, err, ok)
+			}
+			if err == nil && tm != expected {
+				t.Fatalf("Parse(%q) with %+v = %v, reference %v", b, p, tm, expected)
+			}
+		}
+	}
+}
+
+// timeParseReference parses str with time.Parse using the layouts Parser
+// accepts.  time.Parse also allows more than nine digits of fraction, which
+// are rejected here as ParseTime does.
+func timeParseReference(str string, allowMinutes bool) (Time, bool) {
+	if i := strings.IndexByte(str, '.'); i >= 0 && len(str)-i-1 > 9 {
+		return Time{}, false
+	}
+
+	layouts := []string{"15:04:05"}
+	if allowMinutes {
+		layouts = append(layouts, "15:04")
+	}
+	for _, layout := range layouts {
+		if tt, err := time.Parse(layout, str); err == nil {
+			return FromTime(tt), true
+		}
+	}
+
+	return Time{}, false
+}
+
+// extendedReference parses the forms that ParseTime accepts beyond
+// time.Parse: fields with a sign, hours of more than two digits, minutes
+// and seconds of other than two digits, and fields out of range.
+func extendedReference(str string, allowMinutes bool) (Time, bool) {
+	fields := strings.Split(str, ":")
+	if allowMinutes && len(fields) == 2 {
+		fields = append(fields, "00")
+	}
+	if len(fields) != 3 {
+		return Time{}, false
+	}
+
+	ns := 0
+	if i := strings.IndexByte(fields[2], '.'); i >= 0 {
+		fraction := fields[2][i+1:]
+		if fraction == "" || len(fraction) > 9 || strings.Trim(fraction, "0123456789") != "" {
+			return Time{}, false
+		}
+		fields[2] = fields[2][:i]
+		ns, _ = strconv.Atoi((fraction + "00000000")[:9])
+	}
+
+	var values [3]int
+	for i, field := range fields {
+		v, err := strconv.Atoi(field)
+		if err != nil {
+			return Time{}, false
+		}
+		values[i] = v
+	}
+
+	return NewTimeNano(values[0], values[1], values[2], ns), true
+}
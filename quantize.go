@@ -0,0 +1,145 @@
+package clock
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RoundMode is the direction in which a Time is moved onto a grid.
+type RoundMode int
+
+const (
+	// RoundNearest moves a Time to the nearest grid point, rounding half
+	// way values up.
+	RoundNearest RoundMode = iota
+
+	// RoundFloor moves a Time to the grid point at or before it.
+	RoundFloor
+
+	// RoundCeil moves a Time to the grid point at or after it.
+	RoundCeil
+)
+
+// Quantize moves the Time onto a grid of the given width starting at
+// midnight, in the direction of the given mode.  Times rounded past the end
+// of the day wrap around to StartOfDayTime, even if the grid does not divide
+// the day evenly.  If grid is less than one second the Time is returned
+// unchanged.
+func Quantize(t Time, grid time.Duration, mode RoundMode) Time {
+	return QuantizeOffset(t, grid, 0, mode)
+}
+
+// QuantizeOffset is like Quantize, but with the grid shifted by offset from
+// midnight, such as quarter hours starting at :05.  Each day starts its own
+// grid, so Times rounded past the end of the day wrap around to the first
+// grid point of the day and Times rounded before its start to the last.
+func QuantizeOffset(t Time, grid, offset time.Duration, mode RoundMode) Time {
+	width := grid
+	if width < time.Second {
+		return t
+	}
+
+	shift := (offset%width + width) % width
+	x := t.Normalize().sinceMidnight()
+
+	// The grid points either side of x, where the previous day ends with
+	// its last grid point and the next day starts with its first.
+	var lower, upper time.Duration
+	if x < shift {
+		lower = shift + (day-1-shift)/width*width - day
+		upper = shift
+	} else {
+		lower = shift + (x-shift)/width*width
+		upper = lower + width
+		if upper >= day {
+			upper = day + shift
+		}
+	}
+
+	q := lower
+	switch {
+	case x == lower:
+	case mode == RoundCeil:
+		q = upper
+	case mode == RoundNearest && 2*(x-lower) >= upper-lower:
+		q = upper
+	}
+
+	return fromDuration(q)
+}
+
+// FromTimeRounded extracts the wall clock portion of the time.Time, within
+// its own location, and moves it onto a grid of the given granularity
+// starting at midnight, in the direction of the given mode.  It is the same
+// as Quantize of FromTime, except that granularities less than one second
+// are treated as one second.
+func FromTimeRounded(tt time.Time, granularity time.Duration, mode RoundMode) Time {
+	if granularity < time.Second {
+		granularity = time.Second
+	}
+
+	return Quantize(FromTime(tt), granularity, mode)
+}
+
+// WindowKey returns the start of the bucket of the given width that tt falls
+// in, as a local Time of day in loc, along with a key of the form
+// "2006-01-02T15:04:05" naming the local date and bucket.  Buckets start at
+// midnight local time, so the same instant is given the same key by every
+// caller.  If loc is nil DefaultLocation() is used.
+func WindowKey(tt time.Time, loc *time.Location, bucket time.Duration) (Time, string) {
+	if loc == nil {
+		loc = DefaultLocation()
+	}
+	tt = tt.In(loc)
+
+	start := FromTimeRounded(tt, bucket, RoundFloor)
+
+	key := make([]byte, 0, len("2006-01-02T15:04:05"))
+	key = tt.AppendFormat(key, "2006-01-02")
+	key = append(key, 'T')
+	key, _ = start.AppendText(key)
+
+	return start, string(key)
+}
+
+// ErrInvalidGrid indicates that a grid does not divide the day into a whole
+// number of slots of whole seconds.
+var ErrInvalidGrid = errors.New("grid does not divide the day evenly")
+
+// CheckGrid returns an error wrapping ErrInvalidGrid unless the grid is a
+// whole number of seconds that divides the day evenly, such as 15 minutes.
+// SlotIndex, SlotStart, and SlotCount panic for grids that fail the check.
+func CheckGrid(grid time.Duration) error {
+	if grid < time.Second || grid%time.Second != 0 || day%grid != 0 {
+		return fmt.Errorf("grid %s: %w", grid, ErrInvalidGrid)
+	}
+
+	return nil
+}
+
+func mustCheckGrid(grid time.Duration) int {
+	if err := CheckGrid(grid); err != nil {
+		panic("clock: " + err.Error())
+	}
+
+	return int(grid / time.Second)
+}
+
+// SlotCount returns the number of slots of the grid in a day, such as 96 for
+// quarter hours.
+func SlotCount(grid time.Duration) int {
+	return secondsPerDay / mustCheckGrid(grid)
+}
+
+// SlotIndex returns the index, from 0 at midnight, of the slot of the grid
+// that the Time falls in.
+func SlotIndex(t Time, grid time.Duration) int {
+	return t.Normalize().TotalSeconds() / mustCheckGrid(grid)
+}
+
+// SlotStart returns the Time at which the slot of the grid with the given
+// index starts.  Indexes outside of a single day wrap around.
+func SlotStart(index int, grid time.Duration) Time {
+	return fromSeconds(index * mustCheckGrid(grid))
+}
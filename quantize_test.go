@@ -0,0 +1,107 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuantize(t *testing.T) {
+	tm := NewTime(10, 7, 30)
+	assert.Equal(t, NewTime(10, 0, 0), Quantize(tm, 15*time.Minute, RoundFloor))
+	assert.Equal(t, NewTime(10, 15, 0), Quantize(tm, 15*time.Minute, RoundCeil))
+	assert.Equal(t, NewTime(10, 15, 0), Quantize(tm, 15*time.Minute, RoundNearest))
+	assert.Equal(t, NewTime(10, 0, 0), Quantize(NewTime(10, 7, 29), 15*time.Minute, RoundNearest))
+
+	assert.Equal(t, NewTime(10, 15, 0), Quantize(NewTime(10, 15, 0), 15*time.Minute, RoundCeil))
+	assert.Equal(t, StartOfDayTime, Quantize(NewTime(23, 50, 0), 15*time.Minute, RoundCeil))
+	assert.Equal(t, tm, Quantize(tm, 0, RoundCeil))
+
+	// Fractions of a second are taken into account.
+	assert.Equal(t, NewTime(10, 0, 0), Quantize(NewTimeNano(10, 0, 0, 1), time.Second, RoundFloor))
+	assert.Equal(t, NewTime(10, 0, 1), Quantize(NewTimeNano(10, 0, 0, 1), time.Second, RoundCeil))
+	assert.Equal(t, NewTime(10, 7, 30), Quantize(NewTimeNano(10, 7, 29, 600*int(time.Millisecond)), time.Second, RoundNearest))
+	assert.Equal(t, StartOfDayTime, Quantize(NewTimeNano(23, 59, 59, 1), time.Minute, RoundCeil))
+}
+
+func TestQuantizeUnevenGrid(t *testing.T) {
+	// 7 minutes does not divide the day, so the last grid point is 23:55.
+	grid := 7 * time.Minute
+	assert.Equal(t, StartOfDayTime, Quantize(NewTime(23, 58, 0), grid, RoundCeil))
+	assert.Equal(t, StartOfDayTime, Quantize(NewTime(23, 58, 0), grid, RoundNearest))
+	assert.Equal(t, NewTime(23, 55, 0), Quantize(NewTime(23, 57, 0), grid, RoundNearest))
+	assert.Equal(t, NewTime(23, 55, 0), Quantize(NewTime(23, 58, 0), grid, RoundFloor))
+
+	offset := 5 * time.Minute
+	assert.Equal(t, NewTime(0, 5, 0), QuantizeOffset(NewTime(23, 59, 0), grid, offset, RoundCeil))
+	assert.Equal(t, NewTime(23, 53, 0), QuantizeOffset(NewTime(0, 2, 0), grid, offset, RoundFloor))
+	assert.Equal(t, NewTime(0, 5, 0), QuantizeOffset(NewTime(0, 2, 0), grid, -2*time.Minute, RoundCeil))
+}
+
+func TestQuantizeOffset(t *testing.T) {
+	grid, offset := 15*time.Minute, 5*time.Minute
+	assert.Equal(t, NewTime(10, 5, 0), QuantizeOffset(NewTime(10, 7, 30), grid, offset, RoundFloor))
+	assert.Equal(t, NewTime(10, 20, 0), QuantizeOffset(NewTime(10, 7, 30), grid, offset, RoundCeil))
+	assert.Equal(t, NewTime(23, 50, 0), QuantizeOffset(NewTime(0, 2, 0), grid, offset, RoundFloor))
+	assert.Equal(t, NewTime(0, 5, 0), QuantizeOffset(NewTime(0, 2, 0), grid, offset, RoundCeil))
+	assert.Equal(t, NewTime(10, 20, 0), QuantizeOffset(NewTimeNano(10, 5, 0, 1), grid, offset, RoundCeil))
+	assert.Equal(t, NewTimeNano(10, 5, 0, 500*int(time.Millisecond)), QuantizeOffset(NewTime(10, 5, 1), time.Second, 500*time.Millisecond, RoundFloor))
+}
+
+func TestFromTimeRounded(t *testing.T) {
+	tt := time.Date(2021, 5, 1, 10, 7, 29, 600*int(time.Millisecond), time.UTC)
+	assert.Equal(t, NewTime(10, 0, 0), FromTimeRounded(tt, 15*time.Minute, RoundFloor))
+	assert.Equal(t, NewTime(10, 15, 0), FromTimeRounded(tt, 15*time.Minute, RoundCeil))
+	assert.Equal(t, NewTime(10, 0, 0), FromTimeRounded(tt, 15*time.Minute, RoundNearest))
+	assert.Equal(t, NewTime(10, 7, 30), FromTimeRounded(tt, 0, RoundNearest))
+	assert.Equal(t, NewTime(10, 7, 29), FromTimeRounded(tt, time.Second, RoundFloor))
+
+	tt = time.Date(2021, 5, 1, 23, 59, 59, 1, time.UTC)
+	assert.Equal(t, StartOfDayTime, FromTimeRounded(tt, time.Minute, RoundCeil))
+
+	tt = time.Date(2021, 5, 1, 23, 58, 0, 500, time.UTC)
+	for _, mode := range []RoundMode{RoundNearest, RoundFloor, RoundCeil} {
+		assert.Equal(t, Quantize(FromTime(tt), 7*time.Minute, mode), FromTimeRounded(tt, 7*time.Minute, mode))
+	}
+}
+
+func TestWindowKey(t *testing.T) {
+	nyc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := time.Date(2021, 5, 2, 2, 7, 29, 0, time.UTC)
+	start, key := WindowKey(tt, nyc, 15*time.Minute)
+	assert.Equal(t, NewTime(22, 0, 0), start)
+	assert.Equal(t, "2021-05-01T22:00:00", key)
+
+	start, key = WindowKey(tt, time.UTC, time.Hour)
+	assert.Equal(t, NewTime(2, 0, 0), start)
+	assert.Equal(t, "2021-05-02T02:00:00", key)
+
+	_, key = WindowKey(tt.Add(50*time.Minute), time.UTC, time.Hour)
+	assert.Equal(t, "2021-05-02T02:00:00", key)
+}
+
+func TestSlots(t *testing.T) {
+	assert.Nil(t, CheckGrid(15*time.Minute))
+	assert.ErrorIs(t, CheckGrid(7*time.Minute), ErrInvalidGrid)
+	assert.ErrorIs(t, CheckGrid(1500*time.Millisecond), ErrInvalidGrid)
+	assert.ErrorIs(t, CheckGrid(0), ErrInvalidGrid)
+
+	grid := 15 * time.Minute
+	assert.Equal(t, 96, SlotCount(grid))
+	assert.Equal(t, 0, SlotIndex(StartOfDayTime, grid))
+	assert.Equal(t, 40, SlotIndex(NewTime(10, 14, 59), grid))
+	assert.Equal(t, 95, SlotIndex(EndOfDayTime, grid))
+	assert.Equal(t, NewTime(10, 0, 0), SlotStart(40, grid))
+	assert.Equal(t, NewTime(23, 45, 0), SlotStart(-1, grid))
+
+	for i := 0; i < SlotCount(grid); i++ {
+		assert.Equal(t, i, SlotIndex(SlotStart(i, grid), grid))
+	}
+
+	assert.Panics(t, func() { SlotIndex(StartOfDayTime, 7*time.Minute) })
+}
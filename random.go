@@ -0,0 +1,28 @@
+package clock
+
+import (
+	"math/rand"
+	"time"
+)
+
+// RandomWithin returns a uniformly distributed random Time occurring after
+// start and no later than end.  If start occurs after end, then the range is
+// assumed to wrap around midnight.  If rnd is nil, then the default source of
+// the math/rand package is used.  If start and end are equal, start is
+// returned.  Times outside of a single day wrap around it.
+func RandomWithin(start Time, end Time, rnd *rand.Rand) Time {
+	start = start.Normalize()
+	span, _ := start.Diff(end)
+	if span == 0 {
+		return start
+	}
+
+	var offset int64
+	if rnd == nil {
+		offset = rand.Int63n(int64(span)) + 1
+	} else {
+		offset = rnd.Int63n(int64(span)) + 1
+	}
+
+	return start.Add(time.Duration(offset))
+}
@@ -0,0 +1,56 @@
+package clock
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func secondsAfter(start, tm Time) int {
+	return (tm.TotalSeconds() - start.TotalSeconds() + secondsPerDay) % secondsPerDay
+}
+
+func TestRandomWithin(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	start, end := NewTime(22, 0, 0), NewTime(2, 0, 0)
+	beforeMidnight := 0
+	for i := 0; i < 1000; i++ {
+		tm := RandomWithin(start, end, rnd)
+		offset := secondsAfter(start, tm)
+		assert.True(t, offset > 0 && offset <= 4*60*60, tm.String())
+		if tm.After(start) {
+			beforeMidnight++
+		}
+	}
+	assert.InDelta(t, 500, beforeMidnight, 75)
+
+	start, end = NewTime(2, 0, 0), NewTime(4, 0, 0)
+	for i := 0; i < 100; i++ {
+		tm := RandomWithin(start, end, rnd)
+		assert.True(t, tm.Within(start, end), tm.String())
+	}
+
+	assert.Equal(t, start, RandomWithin(start, start, rnd))
+
+	// Times outside of a single day wrap around it.
+	start, end = NewTime(30, 0, 0), NewTime(31, 0, 0)
+	for i := 0; i < 100; i++ {
+		tm := RandomWithin(start, end, rnd)
+		assert.True(t, tm.Within(NewTime(6, 0, 0), NewTime(7, 0, 0)), tm.String())
+	}
+	assert.Equal(t, NewTime(6, 0, 0), RandomWithin(start, start, rnd))
+
+	// Windows shorter than a second are drawn from too.
+	start, end = NewTimeNano(9, 0, 0, 100), NewTimeNano(9, 0, 0, 900)
+	seen := make(map[Time]bool)
+	for i := 0; i < 100000; i++ {
+		tm := RandomWithin(start, end, rnd)
+		if !tm.Within(start, end) {
+			t.Fatalf("%s is not within %s and %s", tm.String(), start.String(), end.String())
+		}
+		seen[tm] = true
+	}
+	assert.Len(t, seen, 800)
+}
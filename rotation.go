@@ -0,0 +1,113 @@
+package clock
+
+import "time"
+
+// Handoff is a weekly point in time at which a Rotation passes to the next
+// participant.
+type Handoff struct {
+	Weekday time.Weekday `json:"weekday"`
+	Time    Time         `json:"time"`
+}
+
+// Rotation is an ordered list of participants taking turns being on call,
+// handing off to the next participant at each of the weekly Handoffs.
+// Handoffs are evaluated in the wall clock of Timezone, so a handoff at
+// 09:00:00 remains at 09:00:00 local time across daylight saving changes.
+type Rotation struct {
+	// Participants is the order in which participants are on call.
+	Participants []string `json:"participants"`
+
+	// Handoffs are the weekly points in time at which the next participant
+	// goes on call.
+	Handoffs []Handoff `json:"handoffs"`
+
+	// Timezone is the timezone the Handoffs are expressed in.  If invalid,
+	// then UTC is used.
+	Timezone string `json:"timezone"`
+
+	// Start is an instant at which the first participant is on call.
+	Start time.Time `json:"start"`
+}
+
+// WhoIsOnCall returns the participant that is on call at the given instant.
+// An empty string is returned if the Rotation has no participants.
+func (r Rotation) WhoIsOnCall(at time.Time) string {
+	n := len(r.Participants)
+	if n == 0 {
+		return ""
+	}
+
+	var index int
+	if at.Before(r.Start) {
+		index = -r.handoffsBetween(at, r.Start)
+	} else {
+		index = r.handoffsBetween(r.Start, at)
+	}
+
+	return r.Participants[(index%n+n)%n]
+}
+
+// NextHandoff returns the first handoff that occurs after the given instant.
+// The zero time.Time is returned if the Rotation has no Handoffs.
+func (r Rotation) NextHandoff(after time.Time) time.Time {
+	loc := loadTimeZone(r.Timezone)
+	after = after.In(loc)
+
+	var next time.Time
+	for i := 0; i <= 7; i++ {
+		for _, instant := range r.handoffsOn(after.AddDate(0, 0, i), loc) {
+			if instant.After(after) && (next.IsZero() || instant.Before(next)) {
+				next = instant
+			}
+		}
+
+		if !next.IsZero() {
+			return next
+		}
+	}
+
+	return next
+}
+
+// handoffsBetween counts the handoffs occurring after from and no later than
+// to.  It expects from to not occur after to.
+func (r Rotation) handoffsBetween(from, to time.Time) int {
+	if len(r.Handoffs) == 0 {
+		return 0
+	}
+
+	loc := loadTimeZone(r.Timezone)
+	from, to = from.In(loc), to.In(loc)
+
+	count := 0
+	if weeks := int(to.Sub(from)/(7*24*time.Hour)) - 1; weeks > 0 {
+		from = from.AddDate(0, 0, 7*weeks)
+		count += weeks * len(r.Handoffs)
+	}
+
+	for day := from; !day.After(to.AddDate(0, 0, 1)); day = day.AddDate(0, 0, 1) {
+		for _, instant := range r.handoffsOn(day, loc) {
+			if instant.After(from) && !instant.After(to) {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+// handoffsOn returns the instants of the handoffs occurring on the date of
+// day in the given location.
+func (r Rotation) handoffsOn(day time.Time, loc *time.Location) []time.Time {
+	var instants []time.Time
+	for _, handoff := range r.Handoffs {
+		if handoff.Weekday != day.Weekday() {
+			continue
+		}
+
+		h, m, s := handoff.Time.HoursMinutesSeconds()
+		instants = append(instants, time.Date(day.Year(), day.Month(), day.Day(), h, m, s, 0, loc))
+	}
+
+	return instants
+}
@@ -0,0 +1,47 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotation(t *testing.T) {
+	nyc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotation := Rotation{
+		Participants: []string{"ana", "bo", "cy"},
+		Handoffs: []Handoff{
+			{Weekday: time.Monday, Time: NewTime(9, 0, 0)},
+			{Weekday: time.Thursday, Time: NewTime(9, 0, 0)},
+		},
+		Timezone: "America/New_York",
+		// Monday 2021-03-01 09:00 is the first handoff, to ana.
+		Start: time.Date(2021, 3, 1, 9, 0, 0, 0, nyc),
+	}
+
+	assert.Equal(t, "ana", rotation.WhoIsOnCall(time.Date(2021, 3, 1, 9, 0, 0, 0, nyc)))
+	assert.Equal(t, "ana", rotation.WhoIsOnCall(time.Date(2021, 3, 4, 8, 59, 59, 0, nyc)))
+	assert.Equal(t, "bo", rotation.WhoIsOnCall(time.Date(2021, 3, 4, 9, 0, 0, 0, nyc)))
+	assert.Equal(t, "cy", rotation.WhoIsOnCall(time.Date(2021, 3, 8, 9, 0, 0, 0, nyc)))
+	assert.Equal(t, "cy", rotation.WhoIsOnCall(time.Date(2021, 3, 1, 8, 0, 0, 0, nyc)))
+
+	// 2021-03-14 is the start of daylight saving time; the handoff stays at
+	// 09:00 local time.
+	assert.Equal(t, "ana", rotation.WhoIsOnCall(time.Date(2021, 3, 15, 8, 59, 0, 0, nyc)))
+	assert.Equal(t, "bo", rotation.WhoIsOnCall(time.Date(2021, 3, 15, 9, 0, 0, 0, nyc)))
+
+	// 52 weeks of two handoffs a week brings the rotation back around by 104.
+	assert.Equal(t, "cy", rotation.WhoIsOnCall(time.Date(2022, 2, 28, 9, 0, 0, 0, nyc)))
+
+	assert.Equal(t,
+		time.Date(2021, 3, 15, 9, 0, 0, 0, nyc),
+		rotation.NextHandoff(time.Date(2021, 3, 11, 9, 0, 0, 0, nyc)),
+	)
+	assert.True(t, Rotation{}.NextHandoff(time.Now()).IsZero())
+	assert.Equal(t, "", Rotation{}.WhoIsOnCall(time.Now()))
+}
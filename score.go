@@ -0,0 +1,20 @@
+package clock
+
+import (
+	"math"
+	"time"
+)
+
+// Score returns the Time as a sorted set score, the seconds into the day
+// including any fraction of a second, so that Times stored in a Redis
+// sorted set can be queried by range with ZRANGEBYSCORE.
+func (t Time) Score() float64 {
+	return float64(t.sinceMidnight()) / float64(time.Second)
+}
+
+// FromScore returns the Time referred to by a sorted set score produced by
+// Time.Score.  Fractional seconds are rounded to the nearest nanosecond and
+// scores outside of a single day wrap around.
+func FromScore(score float64) Time {
+	return fromDuration(time.Duration(math.Round(score * float64(time.Second))))
+}
@@ -0,0 +1,35 @@
+package clock
+
+import (
+	"encoding"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScore(t *testing.T) {
+	tm := NewTime(14, 30, 5)
+	assert.Equal(t, float64(52205), tm.Score())
+	assert.Equal(t, tm, FromScore(tm.Score()))
+	assert.Equal(t, NewTimeNano(14, 30, 5, 900000000), FromScore(52205.9))
+	assert.Equal(t, NewTime(23, 59, 59), FromScore(-1))
+
+	precise := NewTimeNano(14, 30, 5, 123456789)
+	assert.True(t, precise.Score() > tm.Score())
+	assert.True(t, NewTimeNano(14, 30, 5, 123456790).Score() > precise.Score())
+	assert.Equal(t, precise, FromScore(precise.Score()))
+	assert.Equal(t, NewTimeNano(23, 59, 59, 999999999), FromScore(NewTimeNano(23, 59, 59, 999999999).Score()))
+}
+
+func TestRedisCodecInterfaces(t *testing.T) {
+	// go-redis encodes arguments implementing encoding.BinaryMarshaler and
+	// scans into destinations implementing encoding.BinaryUnmarshaler.
+	var marshaler encoding.BinaryMarshaler = NewTime(6, 0, 0)
+	data, err := marshaler.MarshalBinary()
+	assert.Nil(t, err)
+
+	var tm Time
+	var unmarshaler encoding.BinaryUnmarshaler = &tm
+	assert.Nil(t, unmarshaler.UnmarshalBinary(data))
+	assert.Equal(t, NewTime(6, 0, 0), tm)
+}
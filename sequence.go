@@ -0,0 +1,84 @@
+package clock
+
+import "time"
+
+// SequenceTracker reconstructs a timeline from an ordered stream of Times,
+// such as device heartbeats that only report the wall clock time, by
+// inferring when the day rolls over.  A Time earlier than the one before it
+// is taken to be on the following day, unless it is earlier by no more than
+// Tolerance, in which case it is taken to be out of order on the same day.
+// Because of this, consecutive Times must be less than a day apart.  The
+// zero value is ready to use.
+type SequenceTracker struct {
+	// Tolerance is the longest backward step that is treated as reordering
+	// or clock jitter rather than a rollover to the next day.
+	Tolerance time.Duration
+
+	// MaxGap is the longest time between consecutive Times that is not
+	// reported as a gap.  Zero disables gap detection.
+	MaxGap time.Duration
+
+	offset  time.Duration
+	started bool
+}
+
+// Observation describes a Time observed by a SequenceTracker.
+type Observation struct {
+	// Offset is the time since midnight of the day of the first Time.  It
+	// is negative for Times out of order before that midnight.
+	Offset time.Duration
+
+	// Elapsed is the time since the previous Time, which is negative for
+	// Times that are out of order and zero for the first Time.
+	Elapsed time.Duration
+
+	// Rollover reports whether the Time is on a later day than the previous
+	// Time.
+	Rollover bool
+
+	// Gap reports whether Elapsed is longer than MaxGap.
+	Gap bool
+}
+
+// Observe adds the next Time of the stream to the timeline.
+func (s *SequenceTracker) Observe(t Time) Observation {
+	since := t.Normalize().sinceMidnight()
+	if !s.started {
+		s.started = true
+		s.offset = since
+
+		return Observation{Offset: since}
+	}
+
+	elapsed := ((since-s.offset)%day + day) % day
+	if elapsed != 0 && elapsed >= day-s.Tolerance {
+		elapsed -= day
+	}
+
+	previous := s.offset
+	s.offset += elapsed
+
+	return Observation{
+		Offset:   s.offset,
+		Elapsed:  elapsed,
+		Rollover: dayOf(s.offset) > dayOf(previous),
+		Gap:      s.MaxGap > 0 && elapsed > s.MaxGap,
+	}
+}
+
+// Days returns the number of day rollovers since the first Time, which is
+// -1 while the latest Time is out of order before the first midnight.
+func (s *SequenceTracker) Days() int {
+	return dayOf(s.offset)
+}
+
+// dayOf returns the day holding the offset from the first midnight, which is
+// negative for offsets before it.
+func dayOf(offset time.Duration) int {
+	days := offset / day
+	if offset < 0 && offset%day != 0 {
+		days--
+	}
+
+	return int(days)
+}
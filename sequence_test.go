@@ -0,0 +1,65 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSequenceTracker(t *testing.T) {
+	s := SequenceTracker{Tolerance: time.Minute, MaxGap: time.Hour}
+
+	o := s.Observe(NewTime(22, 0, 0))
+	assert.Equal(t, Observation{Offset: 22 * time.Hour}, o)
+
+	o = s.Observe(NewTime(23, 59, 30))
+	assert.Equal(t, 119*time.Minute+30*time.Second, o.Elapsed)
+	assert.True(t, o.Gap)
+	assert.False(t, o.Rollover)
+
+	o = s.Observe(NewTime(0, 0, 10))
+	assert.Equal(t, 24*time.Hour+10*time.Second, o.Offset)
+	assert.Equal(t, 40*time.Second, o.Elapsed)
+	assert.True(t, o.Rollover)
+	assert.False(t, o.Gap)
+	assert.Equal(t, 1, s.Days())
+
+	// Jitter back across midnight stays on the previous day.
+	o = s.Observe(NewTime(23, 59, 50))
+	assert.Equal(t, 23*time.Hour+59*time.Minute+50*time.Second, o.Offset)
+	assert.Equal(t, -20*time.Second, o.Elapsed)
+	assert.False(t, o.Rollover)
+	assert.Equal(t, 0, s.Days())
+
+	// A step back of more than Tolerance is a rollover.
+	s.Observe(NewTime(1, 0, 0))
+	o = s.Observe(NewTime(0, 30, 0))
+	assert.True(t, o.Rollover)
+	assert.Equal(t, 23*time.Hour+30*time.Minute, o.Elapsed)
+	assert.Equal(t, 2, s.Days())
+
+	early := SequenceTracker{Tolerance: time.Minute}
+	early.Observe(NewTime(0, 0, 10))
+	o = early.Observe(NewTime(23, 59, 50))
+	assert.Equal(t, -10*time.Second, o.Offset)
+	assert.False(t, o.Rollover)
+	assert.Equal(t, -1, early.Days())
+	o = early.Observe(NewTime(0, 0, 20))
+	assert.Equal(t, 20*time.Second, o.Offset)
+	assert.Equal(t, 30*time.Second, o.Elapsed)
+	assert.True(t, o.Rollover)
+	assert.Equal(t, 0, early.Days())
+
+	precise := SequenceTracker{}
+	precise.Observe(NewTimeNano(23, 59, 59, 900*int(time.Millisecond)))
+	o = precise.Observe(NewTimeNano(0, 0, 0, 100*int(time.Millisecond)))
+	assert.Equal(t, 200*time.Millisecond, o.Elapsed)
+	assert.True(t, o.Rollover)
+
+	var zero SequenceTracker
+	zero.Observe(NewTime(10, 0, 0))
+	o = zero.Observe(NewTime(10, 0, 0))
+	assert.Equal(t, time.Duration(0), o.Elapsed)
+	assert.False(t, o.Gap)
+}
@@ -0,0 +1,151 @@
+package clock
+
+import "strings"
+
+// SpokenStyle selects the phrasing produced by SpokenAs.
+type SpokenStyle int
+
+const (
+	// SpokenTwelveHour phrases a Time on the 12-hour clock with the part of
+	// the day, such as "eight thirty in the evening".
+	SpokenTwelveHour SpokenStyle = iota
+
+	// SpokenMilitary phrases a Time on the 24-hour clock in military style,
+	// such as "oh nine hundred hours".
+	SpokenMilitary
+)
+
+var (
+	spokenOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+	spokenTens = []string{"", "", "twenty", "thirty", "forty", "fifty"}
+)
+
+// spokenNumber returns the English words for n, from 0 to 59.
+func spokenNumber(n int) string {
+	if n < 20 {
+		return spokenOnes[n]
+	}
+	if n%10 == 0 {
+		return spokenTens[n/10]
+	}
+
+	return spokenTens[n/10] + " " + spokenOnes[n%10]
+}
+
+// spokenMinutes returns the words for minutes following an hour, such as
+// "oh five" or "thirty".
+func spokenMinutes(m int) string {
+	if m < 10 {
+		return "oh " + spokenOnes[m]
+	}
+
+	return spokenNumber(m)
+}
+
+// spokenSeconds returns the words for a non-zero number of seconds.
+func spokenSeconds(s int) string {
+	if s == 1 {
+		return "one second"
+	}
+
+	return spokenNumber(s) + " seconds"
+}
+
+// Spoken returns a deterministic English phrase for the Time suitable for
+// text-to-speech, in the SpokenTwelveHour style.
+func (t Time) Spoken() string {
+	return t.SpokenAs(SpokenTwelveHour)
+}
+
+// SpokenAs returns a deterministic English phrase for the Time in the given
+// style.  Non-zero seconds are always included, so that distinct Times are
+// never phrased the same.
+func (t Time) SpokenAs(style SpokenStyle) string {
+	return t.SpokenIn(EnglishLocale, style)
+}
+
+// SpokenIn returns a deterministic phrase for the Time in the given style
+// and Locale.  If locale is nil, then EnglishLocale is used.
+func (t Time) SpokenIn(locale Locale, style SpokenStyle) string {
+	if locale == nil {
+		locale = EnglishLocale
+	}
+
+	return locale.Spoken(fromSeconds(t.TotalSeconds()), style)
+}
+
+type englishLocale struct{}
+
+// Lookup implements the Vocabulary interface.
+func (englishLocale) Lookup(word string) (Word, bool) {
+	return English.Lookup(word)
+}
+
+// Spoken implements the Locale interface.
+func (englishLocale) Spoken(t Time, style SpokenStyle) string {
+	h, m, s := t.HoursMinutesSeconds()
+
+	var words []string
+	switch style {
+	case SpokenMilitary:
+		switch {
+		case h == 0:
+			words = append(words, "zero")
+		case h < 10:
+			words = append(words, "oh", spokenOnes[h])
+		default:
+			words = append(words, spokenNumber(h))
+		}
+
+		if m == 0 {
+			words = append(words, "hundred")
+		} else {
+			words = append(words, spokenMinutes(m))
+		}
+		words = append(words, "hours")
+
+		if s != 0 {
+			words = append(words, "and", spokenSeconds(s))
+		}
+	default:
+		switch {
+		case h == 0 && m == 0 && s == 0:
+			return "midnight"
+		case h == 12 && m == 0 && s == 0:
+			return "noon"
+		}
+
+		hour := h % 12
+		if hour == 0 {
+			hour = 12
+		}
+		words = append(words, spokenNumber(hour))
+
+		if m == 0 && s == 0 {
+			words = append(words, "o'clock")
+		} else if m != 0 {
+			words = append(words, spokenMinutes(m))
+		}
+
+		if s != 0 {
+			words = append(words, "and", spokenSeconds(s))
+		}
+
+		switch {
+		case h < 5 || h >= 21:
+			words = append(words, "at night")
+		case h < 12:
+			words = append(words, "in the morning")
+		case h < 17:
+			words = append(words, "in the afternoon")
+		default:
+			words = append(words, "in the evening")
+		}
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,38 @@
+package clock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpoken(t *testing.T) {
+	cases := map[Time]string{
+		NewTime(20, 30, 0):  "eight thirty in the evening",
+		NewTime(8, 0, 0):    "eight o'clock in the morning",
+		NewTime(9, 5, 0):    "nine oh five in the morning",
+		NewTime(14, 45, 0):  "two forty five in the afternoon",
+		NewTime(0, 0, 0):    "midnight",
+		NewTime(12, 0, 0):   "noon",
+		NewTime(0, 15, 0):   "twelve fifteen at night",
+		NewTime(12, 0, 1):   "twelve and one second in the afternoon",
+		NewTime(23, 59, 59): "eleven fifty nine and fifty nine seconds at night",
+	}
+	for tm, expected := range cases {
+		assert.Equal(t, expected, tm.Spoken())
+	}
+}
+
+func TestSpokenMilitary(t *testing.T) {
+	cases := map[Time]string{
+		NewTime(9, 0, 0):   "oh nine hundred hours",
+		NewTime(14, 30, 0): "fourteen thirty hours",
+		NewTime(0, 0, 0):   "zero hundred hours",
+		NewTime(0, 5, 0):   "zero oh five hours",
+		NewTime(20, 0, 0):  "twenty hundred hours",
+		NewTime(7, 45, 10): "oh seven forty five hours and ten seconds",
+	}
+	for tm, expected := range cases {
+		assert.Equal(t, expected, tm.SpokenAs(SpokenMilitary))
+	}
+}
@@ -0,0 +1,253 @@
+package clock
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// CircularDistance returns the shortest distance between the Times around
+// the clock, in either direction, which is at most twelve hours.  Unlike
+// DurationBetween it is symmetric, so the distance between 23:00 and 01:00
+// is two hours either way.
+func CircularDistance(a, b Time) time.Duration {
+	d := (a.Normalize().sinceMidnight() - b.Normalize().sinceMidnight()) % day
+	if d < 0 {
+		d = -d
+	}
+	if d > day/2 {
+		d = day - d
+	}
+
+	return d
+}
+
+// CircularMean returns the mean of the Times treated as points on a clock
+// face, so that the mean of 23:00 and 01:00 is midnight rather than noon.
+// False is returned if there are no Times or they are spread so evenly
+// around the day that there is no mean, as with 00:00 and 12:00.
+func CircularMean(times []Time) (Time, bool) {
+	var x, y float64
+	for _, t := range times {
+		angle := 2 * math.Pi * float64(t.Normalize().sinceMidnight()) / float64(day)
+		x += math.Cos(angle)
+		y += math.Sin(angle)
+	}
+
+	if math.Hypot(x, y) < 1e-9*float64(len(times)) || len(times) == 0 {
+		return Time{}, false
+	}
+
+	angle := math.Atan2(y, x)
+	if angle < 0 {
+		angle += 2 * math.Pi
+	}
+
+	return fromDuration(time.Duration(math.Round(angle / (2 * math.Pi) * float64(day)))), true
+}
+
+// CircularMedian returns the median of the Times, measured from the start of
+// the largest gap between them around the clock, so that Times clustered
+// around midnight have a median near midnight.  With an even number of Times
+// the midpoint of the middle two is returned.  False is returned if there
+// are no Times.
+func CircularMedian(times []Time) (Time, bool) {
+	offsets := unroll(times)
+	n := len(offsets)
+	if n == 0 {
+		return Time{}, false
+	}
+
+	if n%2 == 1 {
+		return fromDuration(offsets[n/2]), true
+	}
+
+	return fromDuration(offsets[n/2-1] + (offsets[n/2]-offsets[n/2-1])/2), true
+}
+
+// CircularPercentile returns the Time at the given percentile, between 0 and
+// 1, of the Times ordered from the start of the largest gap between them
+// around the clock.  The 0th percentile is the earliest Time of the group
+// and the 1st the latest, even when the group spans midnight.  False is
+// returned if there are no Times.
+func CircularPercentile(times []Time, p float64) (Time, bool) {
+	offsets := unroll(times)
+	if len(offsets) == 0 {
+		return Time{}, false
+	}
+
+	i := int(math.Ceil(math.Max(0, math.Min(1, p))*float64(len(offsets)))) - 1
+	if i < 0 {
+		i = 0
+	}
+
+	return fromDuration(offsets[i]), true
+}
+
+// ModalWindow returns the start of the window of the given width, wrapping
+// around midnight, that contains the most Times, along with that count.  The
+// window starts at one of the Times, and ties are resolved by the earliest
+// start.  A zero count is returned if there are no Times or width is not
+// positive.
+func ModalWindow(times []Time, width time.Duration) (Time, int) {
+	offsets := sortedOffsets(times)
+	n := len(offsets)
+	if n == 0 || width <= 0 {
+		return Time{}, 0
+	}
+
+	best, bestCount := 0, 0
+	j := 0
+	for i := 0; i < n; i++ {
+		if j < i {
+			j = i
+		}
+		for j < i+n && offsetAt(offsets, j) < offsets[i]+width {
+			j++
+		}
+
+		if count := j - i; count > bestCount {
+			best, bestCount = i, count
+		}
+	}
+
+	return fromDuration(offsets[best]), bestCount
+}
+
+// offsetAt returns the ith of the sorted offsets, continuing into the
+// following day for indexes past the end.
+func offsetAt(offsets []time.Duration, i int) time.Duration {
+	if i < len(offsets) {
+		return offsets[i]
+	}
+
+	return offsets[i-len(offsets)] + day
+}
+
+// sortedOffsets returns the durations from midnight until each of the Times,
+// in increasing order.
+func sortedOffsets(times []Time) []time.Duration {
+	offsets := make([]time.Duration, len(times))
+	for i, t := range times {
+		offsets[i] = t.Normalize().sinceMidnight()
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	return offsets
+}
+
+// unroll returns the sorted offsets of the Times starting after the largest
+// gap between them around the clock, with those that follow midnight
+// continuing past the length of a day.
+func unroll(times []Time) []time.Duration {
+	offsets := sortedOffsets(times)
+	n := len(offsets)
+	if n == 0 {
+		return nil
+	}
+
+	start, gap := 0, offsets[0]+day-offsets[n-1]
+	for i := 1; i < n; i++ {
+		if g := offsets[i] - offsets[i-1]; g > gap {
+			start, gap = i, g
+		}
+	}
+
+	unrolled := make([]time.Duration, n)
+	for i := range unrolled {
+		unrolled[i] = offsetAt(offsets, start+i)
+	}
+
+	return unrolled
+}
+
+// clusterIterations is the number of times Cluster moves the centroids
+// before giving up on the clusters settling.
+var clusterIterations = 100
+
+// Cluster groups the Times into at most k clusters of Times near each other
+// around the clock, using k-means with CircularDistance so that clusters may
+// span midnight.  The centroid of each cluster is its CircularMean.  The
+// initial centroids are spread evenly through the Times, so the result is
+// deterministic.  The clusters are returned in order of their centroids from
+// midnight, and clusters that end up empty are dropped.  Nil is returned if
+// there are no Times or k is less than one.  If the clusters have not
+// settled after 100 iterations, a centroid may differ from the CircularMean
+// of its cluster, but every Time is still grouped with its nearest centroid.
+func Cluster(times []Time, k int) ([]Time, [][]Time) {
+	offsets := unroll(times)
+	n := len(offsets)
+	if n == 0 || k < 1 {
+		return nil, nil
+	}
+	if k > n {
+		k = n
+	}
+
+	centroids := make([]Time, k)
+	for i := range centroids {
+		centroids[i] = fromDuration(offsets[(2*i+1)*n/(2*k)])
+	}
+
+	assignment := make([]int, len(times))
+	var clusters [][]Time
+	for iteration := 0; ; iteration++ {
+		// The assignment is always made against the latest centroids, so
+		// that it holds even when the iterations run out.
+		changed := iteration == 0
+		for i, t := range times {
+			nearest := 0
+			for c := range centroids {
+				if CircularDistance(t, centroids[c]) < CircularDistance(t, centroids[nearest]) {
+					nearest = c
+				}
+			}
+			if assignment[i] != nearest {
+				assignment[i], changed = nearest, true
+			}
+		}
+
+		clusters = make([][]Time, k)
+		for i, t := range times {
+			clusters[assignment[i]] = append(clusters[assignment[i]], t)
+		}
+		if !changed || iteration == clusterIterations {
+			break
+		}
+
+		for c, cluster := range clusters {
+			if mean, ok := CircularMean(cluster); ok {
+				centroids[c] = mean
+			}
+		}
+	}
+
+	var result []Time
+	var grouped [][]Time
+	for c, cluster := range clusters {
+		if len(cluster) > 0 {
+			result = append(result, centroids[c])
+			grouped = append(grouped, cluster)
+		}
+	}
+	sort.Sort(clustersByCentroid{result, grouped})
+
+	return result, grouped
+}
+
+// clustersByCentroid sorts clusters along with their centroids.
+type clustersByCentroid struct {
+	centroids []Time
+	clusters  [][]Time
+}
+
+func (c clustersByCentroid) Len() int { return len(c.centroids) }
+
+func (c clustersByCentroid) Less(i, j int) bool {
+	return c.centroids[i].Compare(c.centroids[j]) < 0
+}
+
+func (c clustersByCentroid) Swap(i, j int) {
+	c.centroids[i], c.centroids[j] = c.centroids[j], c.centroids[i]
+	c.clusters[i], c.clusters[j] = c.clusters[j], c.clusters[i]
+}
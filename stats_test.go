@@ -0,0 +1,133 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircularMean(t *testing.T) {
+	mean, ok := CircularMean([]Time{NewTime(23, 0, 0), NewTime(1, 0, 0)})
+	assert.True(t, ok)
+	assert.Equal(t, StartOfDayTime, mean)
+
+	mean, ok = CircularMean([]Time{NewTime(9, 0, 0), NewTime(10, 0, 0), NewTime(11, 0, 0)})
+	assert.True(t, ok)
+	assert.Equal(t, NewTime(10, 0, 0), mean)
+
+	_, ok = CircularMean([]Time{StartOfDayTime, NewTime(12, 0, 0)})
+	assert.False(t, ok)
+	_, ok = CircularMean(nil)
+	assert.False(t, ok)
+}
+
+func TestCircularMedian(t *testing.T) {
+	times := []Time{NewTime(23, 0, 0), NewTime(0, 30, 0), NewTime(23, 30, 0)}
+	median, ok := CircularMedian(times)
+	assert.True(t, ok)
+	assert.Equal(t, NewTime(23, 30, 0), median)
+
+	median, ok = CircularMedian([]Time{NewTime(23, 0, 0), NewTime(1, 0, 0)})
+	assert.True(t, ok)
+	assert.Equal(t, StartOfDayTime, median)
+
+	_, ok = CircularMedian(nil)
+	assert.False(t, ok)
+}
+
+func TestCircularPercentile(t *testing.T) {
+	times := []Time{NewTime(1, 0, 0), NewTime(22, 0, 0), NewTime(23, 0, 0), NewTime(0, 15, 0)}
+
+	earliest, ok := CircularPercentile(times, 0)
+	assert.True(t, ok)
+	assert.Equal(t, NewTime(22, 0, 0), earliest)
+
+	latest, _ := CircularPercentile(times, 1)
+	assert.Equal(t, NewTime(1, 0, 0), latest)
+
+	half, _ := CircularPercentile(times, 0.5)
+	assert.Equal(t, NewTime(23, 0, 0), half)
+
+	_, ok = CircularPercentile(nil, 0.5)
+	assert.False(t, ok)
+}
+
+func TestModalWindow(t *testing.T) {
+	times := []Time{
+		NewTime(9, 0, 0),
+		NewTime(23, 50, 0),
+		NewTime(0, 5, 0),
+		NewTime(0, 10, 0),
+		NewTime(12, 0, 0),
+	}
+
+	start, count := ModalWindow(times, 30*time.Minute)
+	assert.Equal(t, NewTime(23, 50, 0), start)
+	assert.Equal(t, 3, count)
+
+	start, count = ModalWindow(times, time.Minute)
+	assert.Equal(t, StartOfDayTime.Add(5*time.Minute), start)
+	assert.Equal(t, 1, count)
+
+	_, count = ModalWindow(times, 48*time.Hour)
+	assert.Equal(t, 5, count)
+
+	_, count = ModalWindow(nil, time.Hour)
+	assert.Equal(t, 0, count)
+}
+
+func TestCircularDistance(t *testing.T) {
+	assert.Equal(t, 2*time.Hour, CircularDistance(NewTime(23, 0, 0), NewTime(1, 0, 0)))
+	assert.Equal(t, 2*time.Hour, CircularDistance(NewTime(1, 0, 0), NewTime(23, 0, 0)))
+	assert.Equal(t, 12*time.Hour, CircularDistance(StartOfDayTime, NewTime(12, 0, 0)))
+	assert.Equal(t, time.Duration(0), CircularDistance(NewTime(9, 0, 0), NewTime(9, 0, 0)))
+	assert.Equal(t, 90*time.Minute, CircularDistance(NewTime(9, 0, 0), NewTime(10, 30, 0)))
+	assert.Equal(t, time.Second, CircularDistance(EndOfDayTime, StartOfDayTime))
+}
+
+func TestCluster(t *testing.T) {
+	times := []Time{
+		NewTime(11, 50, 0), NewTime(12, 0, 0), NewTime(12, 10, 0),
+		NewTime(23, 50, 0), NewTime(0, 0, 0), NewTime(0, 10, 0),
+		NewTime(18, 0, 0), NewTime(18, 30, 0),
+	}
+
+	centroids, clusters := Cluster(times, 3)
+	assert.Equal(t, []Time{StartOfDayTime, NewTime(12, 0, 0), NewTime(18, 15, 0)}, centroids)
+	assert.Equal(t, [][]Time{
+		{NewTime(23, 50, 0), NewTime(0, 0, 0), NewTime(0, 10, 0)},
+		{NewTime(11, 50, 0), NewTime(12, 0, 0), NewTime(12, 10, 0)},
+		{NewTime(18, 0, 0), NewTime(18, 30, 0)},
+	}, clusters)
+
+	centroids, clusters = Cluster(times[:2], 5)
+	assert.Len(t, centroids, 2)
+	assert.Len(t, clusters, 2)
+
+	centroids, clusters = Cluster(nil, 3)
+	assert.Nil(t, centroids)
+	assert.Nil(t, clusters)
+}
+
+func TestClusterIterations(t *testing.T) {
+	defer func(n int) { clusterIterations = n }(clusterIterations)
+
+	// The clusters take more than one iteration to settle.
+	times := []Time{
+		NewTime(5, 0, 0), NewTime(16, 0, 0), NewTime(12, 0, 0),
+		NewTime(15, 0, 0), NewTime(18, 0, 0), NewTime(22, 0, 0),
+	}
+
+	for _, n := range []int{0, 1, 100} {
+		clusterIterations = n
+		centroids, clusters := Cluster(times, 2)
+		for c, cluster := range clusters {
+			for _, tm := range cluster {
+				for _, other := range centroids {
+					assert.LessOrEqual(t, CircularDistance(tm, centroids[c]), CircularDistance(tm, other), tm.String())
+				}
+			}
+		}
+	}
+}
@@ -1,19 +1,23 @@
 package clock
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
-// Time deals only with hours, minutes, and seconds.  As opposed to the native
-// time.Time struct that deals also with dates & timezones.
+// Time deals only with hours, minutes, seconds, and nanoseconds.  As opposed
+// to the native time.Time struct that deals also with dates & timezones.
 type Time struct {
 	hours, minutes, seconds int
+	nanoseconds             int
 }
 
 var (
@@ -26,6 +30,10 @@ var (
 	// ErrInvalidTimeFormat indicates that the input string is in
 	// an invalid format.
 	ErrInvalidTimeFormat = errors.New("invalid time format")
+
+	// ErrOutOfRange indicates that the hours, minutes, or seconds of a
+	// time are outside of their valid range.
+	ErrOutOfRange = errors.New("time component out of range")
 )
 
 func init() {
@@ -42,7 +50,95 @@ func NewTime(h, m, s int) Time {
 	}
 }
 
+// NewTimeNano returns a new Time object given hours, minutes, seconds, and
+// nanoseconds.
+func NewTimeNano(h, m, s, ns int) Time {
+	return Time{
+		hours:       h,
+		minutes:     m,
+		seconds:     s,
+		nanoseconds: ns,
+	}
+}
+
+// NewTimeChecked is like NewTime, but returns a *RangeError if any of the
+// hours, minutes, or seconds are outside of 0-23, 0-59, and 0-59
+// respectively.
+func NewTimeChecked(h, m, s int) (Time, error) {
+	if err := checkRange(int64(h), int64(m), int64(s)); err != nil {
+		return Time{}, err
+	}
+
+	return NewTime(h, m, s), nil
+}
+
+// RangeError describes a component of a time that is outside of its valid
+// range.  It wraps ErrOutOfRange.
+type RangeError struct {
+	// Field is the name of the component: "hours", "minutes", or "seconds".
+	Field string
+
+	// Value is the value of the component.
+	Value int64
+
+	// Max is the largest valid value of the component, the smallest being 0.
+	Max int64
+}
+
+// Error implements the error interface.
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("%s %d not in 0-%d: %v", e.Field, e.Value, e.Max, ErrOutOfRange)
+}
+
+// Unwrap returns ErrOutOfRange.
+func (e *RangeError) Unwrap() error {
+	return ErrOutOfRange
+}
+
+// checkRange returns a *RangeError if any of the hours, minutes, or seconds
+// are outside of 0-23, 0-59, and 0-59 respectively.
+func checkRange(h, m, s int64) error {
+	switch {
+	case h < 0 || h > 23:
+		return &RangeError{Field: "hours", Value: h, Max: 23}
+	case m < 0 || m > 59:
+		return &RangeError{Field: "minutes", Value: m, Max: 59}
+	case s < 0 || s > 59:
+		return &RangeError{Field: "seconds", Value: s, Max: 59}
+	}
+
+	return nil
+}
+
+var defaultLocation atomic.Value
+
+// SetDefaultLocation sets the location used by Now, Today, and the other
+// functions of this package that take a timezone, when an empty timezone is
+// given.  Passing nil restores the default of UTC.  It is safe to call from
+// multiple goroutines.
+func SetDefaultLocation(loc *time.Location) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	defaultLocation.Store(loc)
+}
+
+// DefaultLocation returns the location set by SetDefaultLocation.
+func DefaultLocation() *time.Location {
+	loc, ok := defaultLocation.Load().(*time.Location)
+	if !ok {
+		return time.UTC
+	}
+
+	return loc
+}
+
 func loadTimeZone(timezone string) *time.Location {
+	if timezone == "" {
+		return DefaultLocation()
+	}
+
 	loc := time.UTC
 	tzLoc, err := time.LoadLocation(timezone)
 	if err == nil {
@@ -53,7 +149,8 @@ func loadTimeZone(timezone string) *time.Location {
 }
 
 // ParseTime takes in a string of the format: hh:mm:ss
-// and returns a parsed Time object.  If the string is not
+// and returns a parsed Time object.  The seconds may have a fraction of up
+// to nine digits, as in hh:mm:ss.fff.  If the string is not
 // in a valid format ErrInvalidTimeFormat is returned.
 func ParseTime(str string) (*Time, error) {
 	split := strings.Split(str, ":")
@@ -71,33 +168,98 @@ func ParseTime(str string) (*Time, error) {
 		return nil, fmt.Errorf("%v: %w", err, ErrInvalidTimeFormat)
 	}
 
-	seconds, err := strconv.Atoi(split[2])
+	secondsStr, fraction := split[2], ""
+	i := strings.IndexByte(secondsStr, '.')
+	if i >= 0 {
+		secondsStr, fraction = secondsStr[:i], secondsStr[i+1:]
+	}
+
+	seconds, err := strconv.Atoi(secondsStr)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, ErrInvalidTimeFormat)
 	}
 
-	tm := NewTime(hours, minutes, seconds)
+	nanoseconds := 0
+	if i >= 0 {
+		nanoseconds, err = parseFraction(fraction)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tm := NewTimeNano(hours, minutes, seconds, nanoseconds)
 
 	return &tm, nil
 }
 
+// ParseStrict is like ParseTime, but also rejects hours, minutes, or
+// seconds outside of 0-23, 0-59, and 0-59 respectively, such as 25:61:99,
+// with a *RangeError.
+func ParseStrict(str string) (*Time, error) {
+	tm, err := ParseTime(str)
+	if err != nil {
+		return nil, err
+	}
+
+	h, m, s := tm.HoursMinutesSeconds()
+	if err := checkRange(int64(h), int64(m), int64(s)); err != nil {
+		return nil, fmt.Errorf("time %q: %w", str, err)
+	}
+
+	return tm, nil
+}
+
+// parseFraction parses the digits following the decimal point of the
+// seconds as nanoseconds.
+func parseFraction(str string) (int, error) {
+	if str == "" || len(str) > 9 {
+		return 0, fmt.Errorf("fraction %q not 1-9 digits - %w", str, ErrInvalidTimeFormat)
+	}
+
+	ns := 0
+	for i := 0; i < 9; i++ {
+		ns *= 10
+		if i >= len(str) {
+			continue
+		}
+		if str[i] < '0' || str[i] > '9' {
+			return 0, fmt.Errorf("fraction %q not 1-9 digits - %w", str, ErrInvalidTimeFormat)
+		}
+		ns += int(str[i] - '0')
+	}
+
+	return ns, nil
+}
+
 // Now returns the current Time at the sepcified timezone.
+// If an empty timezone is given, then DefaultLocation is used.
 // If an invalid timezone is given, then UTC is used.
 func Now(timezone string) Time {
 	loc := loadTimeZone(timezone)
-	now := time.Now().In(loc)
+	return FromTime(time.Now(), loc)
+}
 
-	return NewTime(now.Hour(), now.Minute(), now.Second())
+// FromTime extracts the wall clock portion of the time.Time, including its
+// nanoseconds, within its own location.  If a location is given the
+// time.Time is first converted to it.  To round away the fraction of the
+// second use FromTimeRounded.
+func FromTime(tt time.Time, loc ...*time.Location) Time {
+	if len(loc) > 0 && loc[0] != nil {
+		tt = tt.In(loc[0])
+	}
+
+	return NewTimeNano(tt.Hour(), tt.Minute(), tt.Second(), tt.Nanosecond())
 }
 
 // Today converts the Time object into a time.Time at the current
-// date given a timezone.
+// date given a timezone.  If an empty timezone is given, then
+// DefaultLocation is used.
 func (t *Time) Today(timezone string) time.Time {
 	loc := loadTimeZone(timezone)
 
 	hours, minutes, seconds := t.HoursMinutesSeconds()
 	now := time.Now().In(loc)
-	return time.Date(now.Year(), now.Month(), now.Day(), hours, minutes, seconds, 0, loc)
+	return time.Date(now.Year(), now.Month(), now.Day(), hours, minutes, seconds, t.nanoseconds, loc)
 }
 
 func digitString(n int) string {
@@ -109,8 +271,19 @@ func digitString(n int) string {
 	return str
 }
 
-// String returns the string representation of Time: hh:mm:ss
+// String returns the string representation of Time: hh:mm:ss, followed by
+// the fraction of the second without trailing zeros if it is not zero, as in
+// hh:mm:ss.fff.
 func (t *Time) String() string {
+	if str, ok := t.cachedString(); ok {
+		return str
+	}
+
+	if t.nanoseconds != 0 {
+		b, _ := t.AppendText(nil)
+		return string(b)
+	}
+
 	return fmt.Sprintf(
 		"%s:%s:%s",
 		digitString(t.hours),
@@ -119,24 +292,32 @@ func (t *Time) String() string {
 	)
 }
 
-// Value implements the sql.Valuer interface so that Time can be used
-// in conjunction with the time type in databases.
-func (t *Time) Value() (driver.Value, error) {
+// Value implements the driver.Valuer interface so that Time can be used
+// in conjunction with the time type in databases.  It has a value receiver
+// so that Time also satisfies the interface when wrapped, as in sql.Null[Time].
+func (t Time) Value() (driver.Value, error) {
 	return t.String(), nil
 }
 
-// Scan implements the sql.Scanner interface.
+// Scan implements the sql.Scanner interface.  It accepts the []byte and
+// string representations of hh:mm:ss as well as time.Time values, which
+// some drivers return for time columns.
 func (t *Time) Scan(src interface{}) error {
-	if src == nil {
+	var str string
+	switch v := src.(type) {
+	case nil:
 		return nil
+	case []byte:
+		str = string(v)
+	case string:
+		str = v
+	case time.Time:
+		*t = FromTime(v)
+		return nil
+	default:
+		return fmt.Errorf("failed to parse clock.Time from sql driver type %T", src)
 	}
 
-	bb, ok := src.([]byte)
-	if !ok {
-		return errors.New("failed to parse util.Time from sql driver")
-	}
-
-	str := string(bb)
 	parsedTime, err := ParseTime(str)
 	if err != nil {
 		return err
@@ -152,8 +333,19 @@ func (t Time) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.String())
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface.
+// isJSONNull reports whether data is the JSON null literal.
+func isJSONNull(data []byte) bool {
+	return string(bytes.TrimSpace(data)) == "null"
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.  A JSON null
+// leaves the Time unchanged, so that *Time fields decode null as nil and
+// Time fields ignore it, matching encoding/json's handling of null.
 func (t *Time) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+
 	str := string(data)
 	tt, err := ParseTime(strings.ReplaceAll(str, `"`, ""))
 	if err != nil {
@@ -165,18 +357,209 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// appendDigits appends n to b as at least two decimal digits.
+func appendDigits(b []byte, n int) []byte {
+	if n >= 0 && n < 10 {
+		b = append(b, '0')
+	}
+
+	return strconv.AppendInt(b, int64(n), 10)
+}
+
+// AppendText implements the encoding.TextAppender interface, appending the
+// representation of Time returned by String to b.
+func (t Time) AppendText(b []byte) ([]byte, error) {
+	if str, ok := t.cachedString(); ok {
+		return append(b, str...), nil
+	}
+
+	b = appendDigits(b, t.hours)
+	b = append(b, ':')
+	b = appendDigits(b, t.minutes)
+	b = append(b, ':')
+	b = appendDigits(b, t.seconds)
+
+	return t.appendFraction(b, PrecisionAuto), nil
+}
+
+// appendFraction appends the decimal point and fraction of the second to b
+// with the number of digits of the precision.  PrecisionAuto appends as many
+// digits as needed, and nothing if the fraction is zero.
+func (t Time) appendFraction(b []byte, p Precision) []byte {
+	digits := 0
+	switch p {
+	case PrecisionMilliseconds:
+		digits = 3
+	case PrecisionMicroseconds:
+		digits = 6
+	case PrecisionNanoseconds:
+		digits = 9
+	case PrecisionAuto:
+		if t.nanoseconds <= 0 || t.nanoseconds >= int(time.Second) {
+			return b
+		}
+		digits = 9
+		for ns := t.nanoseconds; ns%10 == 0; ns /= 10 {
+			digits--
+		}
+	default:
+		return b
+	}
+
+	var buf [9]byte
+	ns := t.nanoseconds
+	for i := 8; i >= 0; i-- {
+		buf[i] = byte('0' + ns%10)
+		ns /= 10
+	}
+	b = append(b, '.')
+
+	return append(b, buf[:digits]...)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t Time) MarshalText() ([]byte, error) {
+	return t.AppendText(make([]byte, 0, len("hh:mm:ss")))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.  Canonical
+// hh:mm:ss input is parsed into t without allocating.
+func (t *Time) UnmarshalText(data []byte) error {
+	if tm, ok := parseCanonical(data); ok {
+		*t = tm
+		return nil
+	}
+
+	tt, err := ParseTime(string(data))
+	if err != nil {
+		return err
+	}
+
+	*t = *tt
+
+	return nil
+}
+
+// binaryLength is the length of the binary encoding of Time without
+// nanoseconds, and binaryNanoLength the length with them.
+const (
+	binaryLength     = 4
+	binaryNanoLength = 8
+)
+
+// AppendBinary implements the encoding.BinaryAppender interface.  The binary
+// layout of Time is stable: the total seconds into the day as a 4-byte
+// big-endian unsigned integer, followed by the nanoseconds as another 4-byte
+// big-endian unsigned integer only if they are non-zero.  Times outside of a
+// single day, which UnmarshalBinary rejects, return an error; Normalize
+// them first.
+func (t Time) AppendBinary(b []byte) ([]byte, error) {
+	if err := checkBinary(int64(t.TotalSeconds()), int64(t.nanoseconds)); err != nil {
+		return b, err
+	}
+
+	var buf [binaryNanoLength]byte
+	binary.BigEndian.PutUint32(buf[:], uint32(t.TotalSeconds()))
+	if t.nanoseconds == 0 {
+		return append(b, buf[:binaryLength]...), nil
+	}
+	binary.BigEndian.PutUint32(buf[binaryLength:], uint32(t.nanoseconds))
+
+	return append(b, buf[:]...), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.  See
+// AppendBinary for the layout.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return t.AppendBinary(make([]byte, 0, binaryLength))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	if len(data) != binaryLength && len(data) != binaryNanoLength {
+		return fmt.Errorf("binary time must be %d or %d bytes, got %d - %w", binaryLength, binaryNanoLength, len(data), ErrInvalidTimeFormat)
+	}
+
+	total := int64(binary.BigEndian.Uint32(data))
+	var ns int64
+	if len(data) == binaryNanoLength {
+		ns = int64(binary.BigEndian.Uint32(data[binaryLength:]))
+	}
+	if err := checkBinary(total, ns); err != nil {
+		return err
+	}
+	*t = NewTimeNano(int(total/3600), int(total/60%60), int(total%60), int(ns))
+
+	return nil
+}
+
+// checkBinary returns an error wrapping ErrInvalidTimeFormat unless the total
+// seconds and nanoseconds are within a single day.
+func checkBinary(total, ns int64) error {
+	if total < 0 || total >= secondsPerDay || ns < 0 || ns >= int64(time.Second) {
+		return fmt.Errorf("binary time of %d seconds and %d nanoseconds out of range - %w", total, ns, ErrInvalidTimeFormat)
+	}
+
+	return nil
+}
+
 // dateTime is an internal method for converting the Time to
 // an arbitrary time.Time.  This is used internally for computing addition
 // and subtraction on Time.
 func (t Time) dateTime() time.Time {
-	return time.Date(2000, 1, 1, t.hours, t.minutes, t.seconds, 0, time.UTC)
+	return time.Date(2000, 1, 1, t.hours, t.minutes, t.seconds, t.nanoseconds, time.UTC)
 }
 
 // Add increments the Time by the given input duration.
 func (t Time) Add(d time.Duration) Time {
 	newDateTime := t.dateTime().Add(d)
 
-	return NewTime(newDateTime.Hour(), newDateTime.Minute(), newDateTime.Second())
+	return NewTimeNano(newDateTime.Hour(), newDateTime.Minute(), newDateTime.Second(), newDateTime.Nanosecond())
+}
+
+// AddClamped increments the Time by the given input duration, saturating at
+// StartOfDayTime or the last nanosecond of the day, 23:59:59.999999999,
+// rather than wrapping around midnight.
+func (t Time) AddClamped(d time.Duration) Time {
+	total := t.sinceMidnight() + d
+	switch {
+	case total < 0:
+		return StartOfDayTime
+	case total >= day:
+		return fromDuration(day - 1)
+	}
+
+	return fromDuration(total)
+}
+
+// fromSeconds returns the Time the given total seconds into the day refers
+// to, wrapping around for values outside of a single day.
+func fromSeconds(total int) Time {
+	total %= secondsPerDay
+	if total < 0 {
+		total += secondsPerDay
+	}
+
+	return NewTime(total/3600, total/60%60, total%60)
+}
+
+// fromDuration returns the Time the given duration into the day refers to,
+// wrapping around for values outside of a single day.
+func fromDuration(d time.Duration) Time {
+	d %= day
+	if d < 0 {
+		d += day
+	}
+
+	tm := fromSeconds(int(d / time.Second))
+	tm.nanoseconds = int(d % time.Second)
+
+	return tm
+}
+
+// sinceMidnight returns the duration from midnight until the Time.
+func (t Time) sinceMidnight() time.Duration {
+	return time.Duration(t.TotalSeconds())*time.Second + time.Duration(t.nanoseconds)
 }
 
 // Sub decrements the Time by the given input duration.
@@ -189,15 +572,45 @@ func (t Time) HoursMinutesSeconds() (int, int, int) {
 	return t.hours, t.minutes, t.seconds
 }
 
-// TotalSeconds returns the total amount of seconds into the day that this Time object is.
+// Hour returns the hours of the Time.
+func (t Time) Hour() int {
+	return t.hours
+}
+
+// Minute returns the minutes within the hour of the Time.
+func (t Time) Minute() int {
+	return t.minutes
+}
+
+// Second returns the seconds within the minute of the Time.
+func (t Time) Second() int {
+	return t.seconds
+}
+
+// Nanosecond returns the nanoseconds within the second of the Time.
+func (t Time) Nanosecond() int {
+	return t.nanoseconds
+}
+
+// TotalSeconds returns the total amount of whole seconds into the day that
+// this Time object is.
 func (t Time) TotalSeconds() int {
 	h, m, s := t.HoursMinutesSeconds()
 	return h*60*60 + m*60 + s
 }
 
+// Normalize returns the Time with its hours, minutes, and seconds brought
+// into range, wrapping around the day.  Times returned by ParseTime and
+// NewTime are not range checked, so a value such as 25:00:00 normalizes to
+// 01:00:00.  Normalizing a Time that is already in range returns it
+// unchanged.
+func (t Time) Normalize() Time {
+	return fromDuration(t.sinceMidnight())
+}
+
 // After returns true fo the Time object occurs after the input Time.
 func (t Time) After(comparison Time) bool {
-	return t.TotalSeconds() > comparison.TotalSeconds()
+	return t.sinceMidnight() > comparison.sinceMidnight()
 }
 
 // Before returns true fo the Time object occurs before the input Time.
@@ -205,24 +618,90 @@ func (t Time) Before(comparison Time) bool {
 	return !t.After(comparison)
 }
 
+// IsZero reports whether the Time is the zero value.  The zero value is
+// 00:00:00, so a Time that was never set cannot be told apart from midnight;
+// use a *Time or sql.Null[Time] where the difference matters.
+func (t Time) IsZero() bool {
+	return t == Time{}
+}
+
+// IsStartOfDay reports whether the Time is exactly StartOfDayTime.
+func (t Time) IsStartOfDay() bool {
+	return t == StartOfDayTime
+}
+
+// IsEndOfDay reports whether the Time is exactly EndOfDayTime.
+func (t Time) IsEndOfDay() bool {
+	return t == EndOfDayTime
+}
+
+// IsBefore reports whether the Time occurs strictly before the input Time.
+// Unlike Before, it is false when the Times are equal.
+func (t Time) IsBefore(comparison Time) bool {
+	return t.sinceMidnight() < comparison.sinceMidnight()
+}
+
+// IsAfter reports whether the Time occurs strictly after the input Time.  It
+// is the same as After.
+func (t Time) IsAfter(comparison Time) bool {
+	return t.After(comparison)
+}
+
+// OnOrBefore reports whether the Time occurs before or at the same time as
+// the input Time.  It is the same as Before.
+func (t Time) OnOrBefore(comparison Time) bool {
+	return !t.IsAfter(comparison)
+}
+
+// OnOrAfter reports whether the Time occurs after or at the same time as the
+// input Time.
+func (t Time) OnOrAfter(comparison Time) bool {
+	return !t.IsBefore(comparison)
+}
+
+// Compare returns -1 if the Time occurs before other, 1 if it occurs after
+// other, and 0 if they are the same time of day, for use with sort.Slice
+// and slices.SortFunc.
+func (t Time) Compare(other Time) int {
+	switch a, b := t.sinceMidnight(), other.sinceMidnight(); {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
 // DurationBetween returns the duration between the two times.  If start occurs
 // after end, then the returned duration assumes that end refers to the following day.
 func DurationBetween(start Time, end Time) time.Duration {
 	if start.After(end) {
-		return time.Duration(
-			EndOfDayTime.TotalSeconds()-start.TotalSeconds()+end.TotalSeconds(),
-		) * time.Second
+		return EndOfDayTime.sinceMidnight() - start.sinceMidnight() + end.sinceMidnight()
 	}
 
-	return time.Duration(end.TotalSeconds()-start.TotalSeconds()) * time.Second
+	return end.sinceMidnight() - start.sinceMidnight()
 }
 
-// Within returns true if the Time occurs within the start and end range.  If start occurs
-// after end, then the returned duration assumes that end refers to the following day.
+// Diff returns the duration from the Time until the other Time, along with
+// whether the duration assumes that other refers to the following day.
+// Unlike DurationBetween, which measures a wrap only up to EndOfDayTime,
+// the duration from 23:00:00 to 07:00:00 is exactly eight hours.
+func (t Time) Diff(other Time) (time.Duration, bool) {
+	d := (other.sinceMidnight() - t.sinceMidnight()) % day
+	if d < 0 {
+		d += day
+	}
+
+	return d, t.After(other)
+}
+
+// Within returns true if the Time occurs within the start and end range, excluding start
+// and including end.  If start occurs after end, then the range assumes that end refers to
+// the following day, and so includes midnight.
 func (t Time) Within(start Time, end Time) bool {
 	if start.After(end) {
-		return (t.After(StartOfDayTime) && t.Before(end)) ||
-			(t.After(start) && t.Before(EndOfDayTime))
+		return t.After(start) || t.OnOrBefore(end)
 	}
 
 	return t.After(start) && t.Before(end)
@@ -2,6 +2,7 @@ package clock
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -21,6 +22,17 @@ func TestWithin(t *testing.T) {
 	end = t1.Add(1 * time.Hour)
 	assert.True(t, t1.Within(start, end))
 	assert.False(t, t1.Within(end, start))
+
+	// Ranges wrapping around midnight include it.
+	start, end = NewTime(22, 0, 0), NewTime(6, 0, 0)
+	assert.True(t, StartOfDayTime.Within(start, end))
+	assert.True(t, NewTimeNano(23, 59, 59, 999999999).Within(start, end))
+	assert.True(t, end.Within(start, end))
+	assert.False(t, start.Within(start, end))
+	assert.True(t, StartOfDayTime.Within(NewTime(23, 0, 0), StartOfDayTime))
+	assert.True(t, StartOfDayTime.Within(EndOfDayTime, NewTime(1, 0, 0)))
+	assert.False(t, StartOfDayTime.Within(StartOfDayTime, NewTime(1, 0, 0)))
+	assert.False(t, NewTime(12, 0, 0).Within(start, end))
 }
 
 func TestJSON(t *testing.T) {
@@ -80,3 +92,277 @@ func TestString(t *testing.T) {
 	tm = NewTime(12, 12, 12)
 	assert.Equal(t, "12:12:12", tm.String())
 }
+
+func TestText(t *testing.T) {
+	tm := NewTime(7, 5, 3)
+	text, err := tm.MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, "07:05:03", string(text))
+
+	var parsed Time
+	assert.Nil(t, parsed.UnmarshalText(text))
+	assert.Equal(t, tm, parsed)
+	assert.NotNil(t, parsed.UnmarshalText([]byte("07:05")))
+}
+
+func TestBinary(t *testing.T) {
+	tm := NewTime(13, 14, 15)
+	data, err := tm.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x00, 0x00, 0xba, 0x27}, data)
+
+	var decoded Time
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, tm, decoded)
+	assert.ErrorIs(t, decoded.UnmarshalBinary([]byte{0x01}), ErrInvalidTimeFormat)
+
+	appended, err := tm.AppendBinary([]byte("x"))
+	assert.Nil(t, err)
+	assert.Equal(t, append([]byte("x"), data...), appended)
+
+	tm = NewTimeNano(13, 14, 15, 500)
+	data, err = tm.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x00, 0x00, 0xba, 0x27, 0x00, 0x00, 0x01, 0xf4}, data)
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, tm, decoded)
+
+	decoded = NewTime(1, 2, 3)
+	for _, data := range [][]byte{
+		{0x00, 0x01, 0x51, 0x80},
+		{0xff, 0xff, 0xff, 0xff},
+		{0x00, 0x00, 0xba, 0x27, 0x3b, 0x9a, 0xca, 0x00},
+	} {
+		assert.ErrorIs(t, decoded.UnmarshalBinary(data), ErrInvalidTimeFormat, data)
+	}
+	assert.Equal(t, NewTime(1, 2, 3), decoded)
+
+	for _, tm := range []Time{NewTime(24, 0, 0), NewTime(0, 0, -1), NewTimeNano(0, 0, 0, int(time.Second))} {
+		appended, err := tm.AppendBinary([]byte("x"))
+		assert.ErrorIs(t, err, ErrInvalidTimeFormat, tm.String())
+		assert.Equal(t, []byte("x"), appended)
+	}
+}
+
+func TestAppendText(t *testing.T) {
+	tm := NewTime(9, 5, 30)
+	text, err := tm.AppendText([]byte("at "))
+	assert.Nil(t, err)
+	assert.Equal(t, "at 09:05:30", string(text))
+}
+
+func TestScan(t *testing.T) {
+	var tm Time
+	assert.Nil(t, tm.Scan([]byte("10:11:12")))
+	assert.Equal(t, NewTime(10, 11, 12), tm)
+
+	assert.Nil(t, tm.Scan("13:14:15"))
+	assert.Equal(t, NewTime(13, 14, 15), tm)
+
+	assert.Nil(t, tm.Scan(time.Date(0, 1, 1, 16, 17, 18, 0, time.UTC)))
+	assert.Equal(t, NewTime(16, 17, 18), tm)
+
+	assert.Nil(t, tm.Scan(nil))
+	assert.Equal(t, NewTime(16, 17, 18), tm)
+
+	assert.NotNil(t, tm.Scan(42))
+
+	value, err := NewTime(1, 2, 3).Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "01:02:03", value)
+}
+
+func TestAddClamped(t *testing.T) {
+	tm := NewTime(23, 30, 0)
+	assert.Equal(t, NewTimeNano(23, 59, 59, 999999999), tm.AddClamped(time.Hour))
+	assert.Equal(t, NewTime(23, 45, 0), tm.AddClamped(15*time.Minute))
+	assert.Equal(t, NewTime(0, 30, 0), tm.Add(time.Hour))
+
+	tm = NewTime(0, 30, 0)
+	assert.Equal(t, StartOfDayTime, tm.AddClamped(-time.Hour))
+	assert.Equal(t, NewTime(0, 15, 0), tm.AddClamped(-15*time.Minute))
+}
+
+func TestDiff(t *testing.T) {
+	d, wrapped := NewTime(9, 0, 0).Diff(NewTime(17, 0, 0))
+	assert.Equal(t, 8*time.Hour, d)
+	assert.False(t, wrapped)
+
+	d, wrapped = NewTime(22, 0, 0).Diff(NewTime(6, 0, 0))
+	assert.Equal(t, 8*time.Hour, d)
+	assert.True(t, wrapped)
+
+	d, wrapped = NewTime(23, 0, 0).Diff(NewTime(7, 0, 0))
+	assert.Equal(t, 8*time.Hour, d)
+	assert.True(t, wrapped)
+
+	d, _ = EndOfDayTime.Diff(StartOfDayTime)
+	assert.Equal(t, time.Second, d)
+
+	d, wrapped = NewTime(9, 0, 0).Diff(NewTime(9, 0, 0))
+	assert.Equal(t, time.Duration(0), d)
+	assert.False(t, wrapped)
+}
+
+func TestDefaultLocation(t *testing.T) {
+	defer SetDefaultLocation(nil)
+	assert.Equal(t, time.UTC, DefaultLocation())
+
+	hawaii, err := time.LoadLocation("US/Hawaii")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	SetDefaultLocation(hawaii)
+	assert.Equal(t, hawaii, DefaultLocation())
+
+	tm := NewTime(8, 30, 0)
+	today := tm.Today("")
+	assert.Equal(t, hawaii, today.Location())
+	assert.Equal(t, 18, today.UTC().Hour())
+
+	assert.Equal(t, time.UTC, tm.Today("UTC").Location())
+
+	SetDefaultLocation(nil)
+	assert.Equal(t, time.UTC, DefaultLocation())
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, NewTime(1, 0, 0), NewTime(25, 0, 0).Normalize())
+	assert.Equal(t, NewTime(10, 1, 5), NewTime(9, 60, 65).Normalize())
+	assert.Equal(t, NewTime(23, 59, 59), NewTime(0, 0, -1).Normalize())
+	assert.Equal(t, NewTime(10, 11, 12), NewTime(10, 11, 12).Normalize())
+}
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, -1, NewTime(9, 0, 0).Compare(NewTime(9, 0, 1)))
+	assert.Equal(t, 1, NewTime(9, 0, 1).Compare(NewTime(9, 0, 0)))
+	assert.Equal(t, 0, NewTime(9, 0, 0).Compare(NewTime(9, 0, 0)))
+}
+
+func TestNanoseconds(t *testing.T) {
+	tm := NewTimeNano(23, 59, 59, 900*int(time.Millisecond))
+	assert.Equal(t, 900*int(time.Millisecond), tm.Nanosecond())
+	assert.Equal(t, 86399, tm.TotalSeconds())
+
+	assert.Equal(t, NewTimeNano(0, 0, 0, 100*int(time.Millisecond)), tm.Add(200*time.Millisecond))
+	assert.Equal(t, tm, tm.Add(200*time.Millisecond).Sub(200*time.Millisecond))
+	assert.Equal(t, tm, tm.AddClamped(0))
+	assert.Equal(t, NewTimeNano(23, 59, 59, 999999999), tm.AddClamped(200*time.Millisecond))
+	assert.Equal(t, NewTimeNano(23, 59, 59, 950*int(time.Millisecond)), tm.AddClamped(50*time.Millisecond))
+	assert.Equal(t, NewTimeNano(23, 59, 59, 800*int(time.Millisecond)), tm.AddClamped(-100*time.Millisecond))
+	assert.Equal(t, NewTimeNano(23, 59, 59, 200*int(time.Millisecond)), NewTime(23, 59, 58).AddClamped(time.Second+200*time.Millisecond))
+	assert.Equal(t, NewTimeNano(23, 59, 59, 999999999), NewTime(23, 59, 58).AddClamped(2*time.Second+200*time.Millisecond))
+
+	before := time.Now().UTC()
+	now := Now("UTC")
+	after := time.Now().UTC()
+	if FromTime(after).After(FromTime(before)) {
+		assert.True(t, now.OnOrAfter(FromTime(before)), now.String())
+		assert.True(t, now.OnOrBefore(FromTime(after)), now.String())
+	}
+
+	assert.True(t, tm.After(EndOfDayTime))
+	assert.Equal(t, 1, tm.Compare(EndOfDayTime))
+	assert.Equal(t, 900*time.Millisecond, DurationBetween(EndOfDayTime, tm))
+	assert.Equal(t, NewTimeNano(0, 0, 1, 5), NewTimeNano(0, 0, 0, int(time.Second)+5).Normalize())
+
+	var scanned Time
+	assert.Nil(t, scanned.Scan(time.Date(0, 1, 1, 10, 11, 12, 123456000, time.UTC)))
+	assert.Equal(t, NewTimeNano(10, 11, 12, 123456000), scanned)
+}
+
+func TestNewTimeChecked(t *testing.T) {
+	tm, err := NewTimeChecked(23, 59, 59)
+	assert.Nil(t, err)
+	assert.Equal(t, EndOfDayTime, tm)
+
+	_, err = NewTimeChecked(99, -5, 1000)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+	assert.Equal(t, "hours 99 not in 0-23: time component out of range", err.Error())
+
+	var rangeErr *RangeError
+	_, err = NewTimeChecked(12, 0, 60)
+	assert.True(t, errors.As(err, &rangeErr))
+	assert.Equal(t, RangeError{Field: "seconds", Value: 60, Max: 59}, *rangeErr)
+}
+
+func TestParseStrict(t *testing.T) {
+	tm, err := ParseStrict("23:59:59.5")
+	assert.Nil(t, err)
+	assert.Equal(t, NewTimeNano(23, 59, 59, 500000000), *tm)
+
+	_, err = ParseStrict("25:61:99")
+	assert.ErrorIs(t, err, ErrOutOfRange)
+	var rangeErr *RangeError
+	assert.True(t, errors.As(err, &rangeErr))
+	assert.Equal(t, "hours", rangeErr.Field)
+
+	_, err = ParseStrict("10:11")
+	assert.ErrorIs(t, err, ErrInvalidTimeFormat)
+
+	tm, err = ParseTime("25:61:99")
+	assert.Nil(t, err)
+	assert.Equal(t, NewTime(25, 61, 99), *tm)
+}
+
+func TestStrictAndInclusiveComparisons(t *testing.T) {
+	early, late := NewTime(9, 0, 0), NewTime(17, 0, 0)
+
+	assert.True(t, early.IsBefore(late))
+	assert.False(t, late.IsBefore(early))
+	assert.False(t, early.IsBefore(early))
+
+	assert.True(t, late.IsAfter(early))
+	assert.False(t, early.IsAfter(late))
+	assert.False(t, early.IsAfter(early))
+
+	assert.True(t, early.OnOrBefore(late))
+	assert.True(t, early.OnOrBefore(early))
+	assert.False(t, late.OnOrBefore(early))
+
+	assert.True(t, late.OnOrAfter(early))
+	assert.True(t, early.OnOrAfter(early))
+	assert.False(t, early.OnOrAfter(late))
+
+	// Before keeps its inclusive behavior.
+	assert.True(t, early.Before(early))
+}
+
+func TestSentinels(t *testing.T) {
+	var zero Time
+	assert.True(t, zero.IsZero())
+	assert.True(t, zero.IsStartOfDay())
+	assert.False(t, NewTime(0, 0, 1).IsZero())
+	assert.False(t, NewTimeNano(0, 0, 0, 1).IsStartOfDay())
+
+	assert.True(t, NewTime(23, 59, 59).IsEndOfDay())
+	assert.False(t, NewTimeNano(23, 59, 59, 1).IsEndOfDay())
+	assert.False(t, zero.IsEndOfDay())
+
+	var body struct {
+		Time Time `json:"time"`
+	}
+	assert.Nil(t, json.Unmarshal([]byte(`{}`), &body))
+	assert.True(t, body.Time.IsZero())
+}
+
+func TestAccessors(t *testing.T) {
+	tm := NewTimeNano(13, 14, 15, 16)
+	assert.Equal(t, 13, tm.Hour())
+	assert.Equal(t, 14, tm.Minute())
+	assert.Equal(t, 15, tm.Second())
+	assert.Equal(t, 16, tm.Nanosecond())
+}
+
+func TestFromTime(t *testing.T) {
+	tt := time.Date(2021, 5, 1, 14, 30, 5, 250, time.UTC)
+	assert.Equal(t, NewTimeNano(14, 30, 5, 250), FromTime(tt))
+
+	nyc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, NewTimeNano(10, 30, 5, 250), FromTime(tt, nyc))
+	assert.Equal(t, NewTimeNano(14, 30, 5, 250), FromTime(tt, nil))
+}
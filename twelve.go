@@ -0,0 +1,94 @@
+package clock
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ParseTime12 takes in a 12-hour clock string of the format: h:mm AM or
+// h:mm:ss PM, where the meridiem is case insensitive and the space before it
+// is optional, and returns a parsed Time object.  If the string is not in a
+// valid format ErrInvalidTimeFormat is returned.
+func ParseTime12(str string) (*Time, error) {
+	rest := strings.ToUpper(strings.TrimSpace(str))
+
+	var pm bool
+	switch {
+	case strings.HasSuffix(rest, "AM"):
+		rest = strings.TrimSuffix(rest, "AM")
+	case strings.HasSuffix(rest, "PM"):
+		rest = strings.TrimSuffix(rest, "PM")
+		pm = true
+	default:
+		return nil, fmt.Errorf("string %q missing AM/PM - %w", str, ErrInvalidTimeFormat)
+	}
+
+	tm, err := parseTimeOrMinutes(strings.TrimSpace(rest))
+	if err != nil {
+		return nil, err
+	}
+
+	h, m, s := tm.HoursMinutesSeconds()
+	if h < 1 || h > 12 || m < 0 || m > 59 || s < 0 || s > 59 {
+		return nil, fmt.Errorf("string %q out of range - %w", str, ErrInvalidTimeFormat)
+	}
+
+	h %= 12
+	if pm {
+		h += 12
+	}
+
+	parsed := NewTimeNano(h, m, s, tm.Nanosecond())
+
+	return &parsed, nil
+}
+
+// Time12 is a Time that leniently accepts 12-hour clock strings such as
+// "2:30 PM", in addition to hh:mm:ss, when unmarshaling.  It is always
+// marshaled in the canonical 24-hour hh:mm:ss form.
+type Time12 struct {
+	Time
+}
+
+// AppendText implements the encoding.TextAppender interface, appending the
+// canonical hh:mm:ss form.
+func (t Time12) AppendText(b []byte) ([]byte, error) {
+	return t.Time.AppendText(b)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t Time12) MarshalText() ([]byte, error) {
+	return t.AppendText(nil)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *Time12) UnmarshalText(data []byte) error {
+	str := string(data)
+
+	tt, err := ParseTime(str)
+	if err != nil {
+		tt, err = ParseTime12(str)
+		if err != nil {
+			return err
+		}
+	}
+
+	t.Time = *tt
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t Time12) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Time12) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return nil
+	}
+
+	return t.UnmarshalText([]byte(strings.ReplaceAll(string(data), `"`, "")))
+}
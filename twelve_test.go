@@ -0,0 +1,44 @@
+package clock
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTime12(t *testing.T) {
+	cases := map[string]Time{
+		"2:30 PM":       NewTime(14, 30, 0),
+		"2:30pm":        NewTime(14, 30, 0),
+		"12:00 AM":      NewTime(0, 0, 0),
+		"12:15:30 pm":   NewTime(12, 15, 30),
+		"11:59:59 PM":   NewTime(23, 59, 59),
+		"9:05:01.25 AM": NewTimeNano(9, 5, 1, 250000000),
+	}
+	for input, expected := range cases {
+		tm, err := ParseTime12(input)
+		assert.Nil(t, err, input)
+		assert.Equal(t, expected, *tm, input)
+	}
+
+	for _, input := range []string{"14:30", "13:00 PM", "0:30 AM", "2:61 PM"} {
+		_, err := ParseTime12(input)
+		assert.ErrorIs(t, err, ErrInvalidTimeFormat, input)
+	}
+}
+
+func TestTime12JSON(t *testing.T) {
+	var body struct {
+		At Time12 `json:"at"`
+	}
+	assert.Nil(t, json.Unmarshal([]byte(`{"at":"2:30 PM"}`), &body))
+	assert.Equal(t, NewTime(14, 30, 0), body.At.Time)
+
+	raw, err := json.Marshal(body)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"at":"14:30:00"}`, string(raw))
+
+	assert.Nil(t, json.Unmarshal([]byte(`{"at":"08:15:00"}`), &body))
+	assert.Equal(t, NewTime(8, 15, 0), body.At.Time)
+}
@@ -0,0 +1,74 @@
+package clock
+
+import (
+	"fmt"
+	"time"
+)
+
+// ZoneTime is the wall clock equivalent of a Time within a particular zone.
+type ZoneTime struct {
+	// Location is the zone the Time is expressed in.
+	Location *time.Location
+
+	// Zone is the abbreviated name of the zone in effect, such as "CEST".
+	Zone string
+
+	// Time is the wall clock Time within the zone.
+	Time Time
+
+	// DayOffset is the number of days the date within the zone differs from
+	// the source date, typically -1, 0, or 1.
+	DayOffset int
+}
+
+// String returns the string representation of the ZoneTime, for example
+// "16:00:00 CEST" or "07:00:00 PDT -1".
+func (z ZoneTime) String() string {
+	str := fmt.Sprintf("%s %s", z.Time.String(), z.Zone)
+	if z.DayOffset != 0 {
+		str = fmt.Sprintf("%s %+d", str, z.DayOffset)
+	}
+
+	return str
+}
+
+// InZones returns the wall clock equivalent of the Time, occurring on the given
+// date in the source timezone, within each of the target timezones.  If an
+// invalid timezone is given, then UTC is used.
+func (t Time) InZones(timezone string, date time.Time, targets ...string) []ZoneTime {
+	loc := loadTimeZone(timezone)
+
+	instant := t.on(date, loc)
+
+	zoneTimes := make([]ZoneTime, len(targets))
+	for i, target := range targets {
+		zoneTimes[i] = zoneTimeOf(instant, loadTimeZone(target))
+	}
+
+	return zoneTimes
+}
+
+// on returns the instant the Time occurs at on the given date in loc.
+func (t Time) on(date time.Time, loc *time.Location) time.Time {
+	h, m, s := t.HoursMinutesSeconds()
+	day := date.In(loc)
+
+	return time.Date(day.Year(), day.Month(), day.Day(), h, m, s, t.Nanosecond(), loc)
+}
+
+// zoneTimeOf returns the wall clock equivalent of the instant within loc,
+// with the day offset relative to the date of the instant in its own
+// location.
+func zoneTimeOf(instant time.Time, loc *time.Location) ZoneTime {
+	sourceDate := time.Date(instant.Year(), instant.Month(), instant.Day(), 0, 0, 0, 0, time.UTC)
+	local := instant.In(loc)
+	localDate := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	zone, _ := local.Zone()
+
+	return ZoneTime{
+		Location:  loc,
+		Zone:      zone,
+		Time:      FromTime(local),
+		DayOffset: int(localDate.Sub(sourceDate) / (24 * time.Hour)),
+	}
+}
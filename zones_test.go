@@ -0,0 +1,28 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInZones(t *testing.T) {
+	date := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	zoneTimes := NewTime(2, 0, 0).InZones("UTC", date, "America/Los_Angeles", "Europe/Berlin", "UTC")
+
+	assert.Len(t, zoneTimes, 3)
+	assert.Equal(t, NewTime(19, 0, 0), zoneTimes[0].Time)
+	assert.Equal(t, -1, zoneTimes[0].DayOffset)
+	assert.Equal(t, "19:00:00 PDT -1", zoneTimes[0].String())
+
+	assert.Equal(t, NewTime(4, 0, 0), zoneTimes[1].Time)
+	assert.Equal(t, 0, zoneTimes[1].DayOffset)
+	assert.Equal(t, "04:00:00 CEST", zoneTimes[1].String())
+
+	assert.Equal(t, "02:00:00 UTC", zoneTimes[2].String())
+
+	precise := NewTimeNano(2, 0, 0, 250*int(time.Millisecond))
+	zoneTimes = precise.InZones("UTC", date, "Europe/Berlin")
+	assert.Equal(t, NewTimeNano(4, 0, 0, 250*int(time.Millisecond)), zoneTimes[0].Time)
+}